@@ -114,7 +114,7 @@ func (s *AgentCoordinationService) SendMessage(ctx context.Context, req *SendMes
 
 	var result SendMessageResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-coordination/messages", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-coordination/messages"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +139,7 @@ func (s *AgentCoordinationService) DistributeTasks(ctx context.Context, req *Dis
 
 	var result DistributeTasksResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-coordination/distribute", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-coordination/distribute"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -153,11 +153,8 @@ func (s *AgentCoordinationService) GetWorkloadStats(ctx context.Context, req *Ge
 		req = &GetWorkloadStatsRequest{}
 	}
 
-	path := "/api/v1/agent-coordination/workload"
-
-	// Note: agent_ids filtering would be handled by the API server
-	// For now, we send the request without query parameters
-	// and let the server return all workload stats
+	path := endpoint("/api/v1/agent-coordination/workload").
+		QueryValues("agent_ids", req.AgentIDs)
 
 	var result GetWorkloadStatsResponse
 
@@ -2,7 +2,6 @@ package ainative
 
 import (
 	"context"
-	"fmt"
 	"time"
 )
 
@@ -112,7 +111,7 @@ func (s *AgentLearningService) SubmitFeedback(ctx context.Context, req *SubmitFe
 
 	var result SubmitFeedbackResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-learning/feedback", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-learning/feedback"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -130,11 +129,9 @@ func (s *AgentLearningService) GetPerformanceMetrics(ctx context.Context, req *G
 		return nil, NewValidationError("agent_id", "agent ID is required", req.AgentID)
 	}
 
-	path := fmt.Sprintf("/api/v1/agent-learning/metrics?agent_id=%s", req.AgentID)
-
-	if req.Period != "" {
-		path += fmt.Sprintf("&period=%s", req.Period)
-	}
+	path := endpoint("/api/v1/agent-learning/metrics").
+		Query("agent_id", req.AgentID).
+		Query("period", req.Period)
 
 	var result GetPerformanceMetricsResponse
 
@@ -163,7 +160,7 @@ func (s *AgentLearningService) CompareAgents(ctx context.Context, req *CompareAg
 
 	var result CompareAgentsResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-learning/compare", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-learning/compare"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,6 @@ package ainative
 
 import (
 	"context"
-	"fmt"
 	"time"
 )
 
@@ -55,12 +54,14 @@ type ListTasksRequest struct {
 	Status  string `json:"status,omitempty"`
 	Limit   int    `json:"limit,omitempty"`
 	Offset  int    `json:"offset,omitempty"`
+	Cursor  string `json:"cursor,omitempty"`
 }
 
 // ListTasksResponse represents a response containing tasks
 type ListTasksResponse struct {
-	Tasks []Task `json:"tasks"`
-	Total int    `json:"total"`
+	Tasks      []Task `json:"tasks"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // TaskStatusResponse represents task status details
@@ -127,7 +128,7 @@ func (s *AgentOrchestrationService) CreateTask(ctx context.Context, req *CreateT
 
 	var result CreateTaskResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-orchestration/tasks", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-orchestration/tasks"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -145,15 +146,12 @@ func (s *AgentOrchestrationService) ListTasks(ctx context.Context, req *ListTask
 		req.Limit = 10
 	}
 
-	path := fmt.Sprintf("/api/v1/agent-orchestration/tasks?limit=%d&offset=%d", req.Limit, req.Offset)
-
-	if req.AgentID != "" {
-		path += fmt.Sprintf("&agent_id=%s", req.AgentID)
-	}
-
-	if req.Status != "" {
-		path += fmt.Sprintf("&status=%s", req.Status)
-	}
+	path := endpoint("/api/v1/agent-orchestration/tasks").
+		QueryInt("limit", req.Limit).
+		QueryInt("offset", req.Offset).
+		Query("cursor", req.Cursor).
+		Query("agent_id", req.AgentID).
+		Query("status", req.Status)
 
 	var result ListTasksResponse
 
@@ -165,6 +163,31 @@ func (s *AgentOrchestrationService) ListTasks(ctx context.Context, req *ListTask
 	return &result, nil
 }
 
+// ListAllTasks returns a Pager over every task matching req, fetching req.Limit
+// tasks per page
+func (s *AgentOrchestrationService) ListAllTasks(ctx context.Context, req *ListTasksRequest) *Pager[Task] {
+	query := ListTasksRequest{}
+	if req != nil {
+		query = *req
+	}
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+
+	return newPager(ctx, query.Limit, query.Offset, func(ctx context.Context, cursor string, offset int) (*Page[Task], error) {
+		page := query
+		page.Cursor = cursor
+		page.Offset = offset
+
+		result, err := s.ListTasks(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[Task]{Items: result.Tasks, NextCursor: result.NextCursor, Total: result.Total}, nil
+	})
+}
+
 // GetTaskStatus retrieves task status and progress
 func (s *AgentOrchestrationService) GetTaskStatus(ctx context.Context, taskID string) (*TaskStatusResponse, error) {
 	if taskID == "" {
@@ -173,7 +196,7 @@ func (s *AgentOrchestrationService) GetTaskStatus(ctx context.Context, taskID st
 
 	var result TaskStatusResponse
 
-	path := fmt.Sprintf("/api/v1/agent-orchestration/tasks/%s/status", taskID)
+	path := endpoint("/api/v1/agent-orchestration/tasks/%s/status", taskID)
 
 	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
 	if err != nil {
@@ -195,7 +218,7 @@ func (s *AgentOrchestrationService) ExecuteTask(ctx context.Context, req *Execut
 
 	var result ExecuteTaskResponse
 
-	path := fmt.Sprintf("/api/v1/agent-orchestration/tasks/%s/execute", req.TaskID)
+	path := endpoint("/api/v1/agent-orchestration/tasks/%s/execute", req.TaskID)
 
 	body := map[string]interface{}{
 		"params": req.Params,
@@ -225,7 +248,7 @@ func (s *AgentOrchestrationService) CreateTaskSequence(ctx context.Context, req
 
 	var result CreateTaskSequenceResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-orchestration/sequences", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-orchestration/sequences"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,6 @@ package ainative
 
 import (
 	"context"
-	"fmt"
 	"time"
 )
 
@@ -88,6 +87,7 @@ type ListCheckpointsRequest struct {
 	AgentID string `json:"agent_id,omitempty"`
 	Limit   int    `json:"limit,omitempty"`
 	Offset  int    `json:"offset,omitempty"`
+	Cursor  string `json:"cursor,omitempty"`
 }
 
 // ListCheckpointsResponse represents the response containing checkpoints
@@ -96,6 +96,7 @@ type ListCheckpointsResponse struct {
 	TotalCount  int          `json:"total_count"`
 	Limit       int          `json:"limit"`
 	Offset      int          `json:"offset"`
+	NextCursor  string       `json:"next_cursor,omitempty"`
 }
 
 // GetState retrieves the current state of an agent
@@ -108,11 +109,9 @@ func (s *AgentStateService) GetState(ctx context.Context, req *GetStateRequest)
 		return nil, NewValidationError("agent_id", "agent ID is required", req.AgentID)
 	}
 
-	path := fmt.Sprintf("/api/v1/agent-state/state?agent_id=%s", req.AgentID)
-
-	if req.Version > 0 {
-		path += fmt.Sprintf("&version=%d", req.Version)
-	}
+	path := endpoint("/api/v1/agent-state/state").
+		Query("agent_id", req.AgentID).
+		QueryPositiveInt("version", req.Version)
 
 	var result GetStateResponse
 
@@ -144,7 +143,7 @@ func (s *AgentStateService) CreateCheckpoint(ctx context.Context, req *CreateChe
 
 	var result CreateCheckpointResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-state/checkpoints", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-state/checkpoints"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +167,7 @@ func (s *AgentStateService) RestoreCheckpoint(ctx context.Context, req *RestoreC
 
 	var result RestoreCheckpointResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-state/restore", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-state/restore"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -186,11 +185,11 @@ func (s *AgentStateService) ListCheckpoints(ctx context.Context, req *ListCheckp
 		req.Limit = 10
 	}
 
-	path := fmt.Sprintf("/api/v1/agent-state/checkpoints?limit=%d&offset=%d", req.Limit, req.Offset)
-
-	if req.AgentID != "" {
-		path += fmt.Sprintf("&agent_id=%s", req.AgentID)
-	}
+	path := endpoint("/api/v1/agent-state/checkpoints").
+		QueryInt("limit", req.Limit).
+		QueryInt("offset", req.Offset).
+		Query("cursor", req.Cursor).
+		Query("agent_id", req.AgentID)
 
 	var result ListCheckpointsResponse
 
@@ -201,3 +200,28 @@ func (s *AgentStateService) ListCheckpoints(ctx context.Context, req *ListCheckp
 
 	return &result, nil
 }
+
+// ListAllCheckpoints returns a Pager over every checkpoint matching req, fetching req.Limit
+// checkpoints per page
+func (s *AgentStateService) ListAllCheckpoints(ctx context.Context, req *ListCheckpointsRequest) *Pager[Checkpoint] {
+	query := ListCheckpointsRequest{}
+	if req != nil {
+		query = *req
+	}
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+
+	return newPager(ctx, query.Limit, query.Offset, func(ctx context.Context, cursor string, offset int) (*Page[Checkpoint], error) {
+		page := query
+		page.Cursor = cursor
+		page.Offset = offset
+
+		result, err := s.ListCheckpoints(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[Checkpoint]{Items: result.Checkpoints, NextCursor: result.NextCursor, Total: result.TotalCount}, nil
+	})
+}
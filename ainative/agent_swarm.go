@@ -2,7 +2,6 @@ package ainative
 
 import (
 	"context"
-	"fmt"
 	"time"
 )
 
@@ -149,6 +148,7 @@ type ListSwarmsRequest struct {
 	Status    SwarmStatus `json:"status,omitempty"`
 	Limit     int         `json:"limit,omitempty"`
 	Offset    int         `json:"offset,omitempty"`
+	Cursor    string      `json:"cursor,omitempty"`
 }
 
 // ListSwarmsResponse represents a response containing swarms
@@ -157,6 +157,7 @@ type ListSwarmsResponse struct {
 	TotalCount int          `json:"total_count"`
 	Limit      int          `json:"limit"`
 	Offset     int          `json:"offset"`
+	NextCursor string       `json:"next_cursor,omitempty"`
 }
 
 // OrchestrationTask represents a task for agent orchestration
@@ -235,7 +236,7 @@ func (s *AgentSwarmService) Start(ctx context.Context, req *StartSwarmRequest) (
 	
 	var result AgentSwarm
 	
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-swarm/swarms", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-swarm/swarms"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +252,7 @@ func (s *AgentSwarmService) Get(ctx context.Context, swarmID string) (*AgentSwar
 	
 	var result AgentSwarm
 	
-	path := fmt.Sprintf("/api/v1/agent-swarm/swarms/%s", swarmID)
+	path := endpoint("/api/v1/agent-swarm/swarms/%s", swarmID)
 	
 	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
 	if err != nil {
@@ -271,15 +272,12 @@ func (s *AgentSwarmService) List(ctx context.Context, req *ListSwarmsRequest) (*
 		req.Limit = 10
 	}
 	
-	path := fmt.Sprintf("/api/v1/agent-swarm/swarms?limit=%d&offset=%d", req.Limit, req.Offset)
-	
-	if req.ProjectID != "" {
-		path += fmt.Sprintf("&project_id=%s", req.ProjectID)
-	}
-	
-	if req.Status != "" {
-		path += fmt.Sprintf("&status=%s", req.Status)
-	}
+	path := endpoint("/api/v1/agent-swarm/swarms").
+		QueryInt("limit", req.Limit).
+		QueryInt("offset", req.Offset).
+		Query("cursor", req.Cursor).
+		Query("project_id", req.ProjectID).
+		Query("status", string(req.Status))
 	
 	var result ListSwarmsResponse
 	
@@ -291,13 +289,38 @@ func (s *AgentSwarmService) List(ctx context.Context, req *ListSwarmsRequest) (*
 	return &result, nil
 }
 
+// ListAll returns a Pager over every swarm matching req, fetching req.Limit
+// swarms per page
+func (s *AgentSwarmService) ListAll(ctx context.Context, req *ListSwarmsRequest) *Pager[AgentSwarm] {
+	query := ListSwarmsRequest{}
+	if req != nil {
+		query = *req
+	}
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+	
+	return newPager(ctx, query.Limit, query.Offset, func(ctx context.Context, cursor string, offset int) (*Page[AgentSwarm], error) {
+		page := query
+		page.Cursor = cursor
+		page.Offset = offset
+		
+		result, err := s.List(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+		
+		return &Page[AgentSwarm]{Items: result.Swarms, NextCursor: result.NextCursor, Total: result.TotalCount}, nil
+	})
+}
+
 // Stop stops a running swarm
 func (s *AgentSwarmService) Stop(ctx context.Context, swarmID string) error {
 	if swarmID == "" {
 		return NewValidationError("swarm_id", "swarm ID is required", swarmID)
 	}
 	
-	path := fmt.Sprintf("/api/v1/agent-swarm/swarms/%s/stop", swarmID)
+	path := endpoint("/api/v1/agent-swarm/swarms/%s/stop", swarmID)
 	
 	return s.client.makeRequest(ctx, "POST", path, nil, nil)
 }
@@ -308,7 +331,7 @@ func (s *AgentSwarmService) Pause(ctx context.Context, swarmID string) error {
 		return NewValidationError("swarm_id", "swarm ID is required", swarmID)
 	}
 	
-	path := fmt.Sprintf("/api/v1/agent-swarm/swarms/%s/pause", swarmID)
+	path := endpoint("/api/v1/agent-swarm/swarms/%s/pause", swarmID)
 	
 	return s.client.makeRequest(ctx, "POST", path, nil, nil)
 }
@@ -319,7 +342,7 @@ func (s *AgentSwarmService) Resume(ctx context.Context, swarmID string) error {
 		return NewValidationError("swarm_id", "swarm ID is required", swarmID)
 	}
 	
-	path := fmt.Sprintf("/api/v1/agent-swarm/swarms/%s/resume", swarmID)
+	path := endpoint("/api/v1/agent-swarm/swarms/%s/resume", swarmID)
 	
 	return s.client.makeRequest(ctx, "POST", path, nil, nil)
 }
@@ -344,7 +367,7 @@ func (s *AgentSwarmService) Orchestrate(ctx context.Context, req *OrchestrationR
 	
 	var result OrchestrationResponse
 	
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/agent-swarm/orchestrate", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/agent-swarm/orchestrate"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -360,7 +383,7 @@ func (s *AgentSwarmService) GetTask(ctx context.Context, taskID string) (*Orches
 	
 	var result OrchestrationTask
 	
-	path := fmt.Sprintf("/api/v1/agent-swarm/tasks/%s", taskID)
+	path := endpoint("/api/v1/agent-swarm/tasks/%s", taskID)
 	
 	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
 	if err != nil {
@@ -376,7 +399,7 @@ func (s *AgentSwarmService) ListAgentTypes(ctx context.Context) ([]AgentType, er
 		AgentTypes []AgentType `json:"agent_types"`
 	}
 	
-	err := s.client.makeRequest(ctx, "GET", "/api/v1/agent-swarm/agent-types", nil, &result)
+	err := s.client.makeRequest(ctx, "GET", endpoint("/api/v1/agent-swarm/agent-types"), nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -392,7 +415,7 @@ func (s *AgentSwarmService) GetSwarmMetrics(ctx context.Context, swarmID string)
 	
 	var result SwarmMetrics
 	
-	path := fmt.Sprintf("/api/v1/agent-swarm/swarms/%s/metrics", swarmID)
+	path := endpoint("/api/v1/agent-swarm/swarms/%s/metrics", swarmID)
 	
 	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
 	if err != nil {
@@ -0,0 +1,194 @@
+//go:build ignore
+
+// gen generates mocks.go from the interfaces declared in ../interfaces.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	source = "../interfaces.go"
+	output = "mocks.go"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, source, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package ainativemock\n\n")
+	buf.WriteString("import (\n\t\"context\"\n\n\t\"github.com/ainative/go-sdk/ainative\"\n)\n")
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if iface, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+				writeMock(&buf, typeSpec.Name.Name, iface)
+			}
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+	if err := os.WriteFile(output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// param is a named method parameter
+type param struct {
+	name string
+	typ  string
+}
+
+// writeMock writes the mock type and methods for an interface
+func writeMock(buf *bytes.Buffer, name string, iface *ast.InterfaceType) {
+	fmt.Fprintf(buf, "\n// %s is a mock of ainative.%s\n", name, name)
+	fmt.Fprintf(buf, "type %s struct {\n\tRecorder\n\n", name)
+	for _, method := range iface.Methods.List {
+		params, results := signature(method.Type.(*ast.FuncType))
+		fmt.Fprintf(buf, "\t%sFunc func(%s) %s\n", method.Names[0].Name, paramList(params), resultList(results))
+	}
+	buf.WriteString("}\n")
+	fmt.Fprintf(buf, "\nvar _ ainative.%s = (*%s)(nil)\n", name, name)
+
+	for _, method := range iface.Methods.List {
+		writeMethod(buf, name, method.Names[0].Name, method.Type.(*ast.FuncType))
+	}
+}
+
+// writeMethod writes a mock method that records the call and invokes its Func
+// field
+func writeMethod(buf *bytes.Buffer, mock, method string, fn *ast.FuncType) {
+	params, results := signature(fn)
+
+	var args, recorded []string
+	for _, p := range params {
+		args = append(args, p.name)
+		if p.typ != "context.Context" {
+			recorded = append(recorded, p.name)
+		}
+	}
+
+	fmt.Fprintf(buf, "\n// %s calls %sFunc, or fails with ErrNotMocked when it is nil\n", method, method)
+	fmt.Fprintf(buf, "func (m *%s) %s(%s) %s {\n", mock, method, paramList(params), resultList(results))
+	fmt.Fprintf(buf, "\tm.record(%s)\n", strings.Join(append([]string{fmt.Sprintf("%q", method)}, recorded...), ", "))
+	fmt.Fprintf(buf, "\tif m.%sFunc == nil {\n", method)
+	buf.WriteString(notMockedReturn(mock+"."+method, results))
+	buf.WriteString("\t}\n")
+	fmt.Fprintf(buf, "\treturn m.%sFunc(%s)\n}\n", method, strings.Join(args, ", "))
+}
+
+// notMockedReturn returns the statements run when a Func field is nil
+func notMockedReturn(method string, results []string) string {
+	errExpr := fmt.Sprintf("notMocked(%q)", method)
+
+	// Methods returning only a pager report the error through it
+	if len(results) == 1 && strings.HasPrefix(results[0], "*ainative.Pager[") {
+		elem := strings.TrimSuffix(strings.TrimPrefix(results[0], "*ainative.Pager["), "]")
+		return fmt.Sprintf("\t\treturn ainative.NewSlicePager[%s](nil, %s)\n", elem, errExpr)
+	}
+
+	var b strings.Builder
+	var values []string
+	for i, result := range results {
+		if result == "error" {
+			values = append(values, errExpr)
+			continue
+		}
+		fmt.Fprintf(&b, "\t\tvar r%d %s\n", i, result)
+		values = append(values, fmt.Sprintf("r%d", i))
+	}
+	fmt.Fprintf(&b, "\t\treturn %s\n", strings.Join(values, ", "))
+	return b.String()
+}
+
+// signature returns the parameters and result types of fn, qualifying
+// identifiers from package ainative
+func signature(fn *ast.FuncType) ([]param, []string) {
+	var params []param
+	for _, field := range fn.Params.List {
+		typ := typeString(field.Type)
+		for _, name := range field.Names {
+			params = append(params, param{name: name.Name, typ: typ})
+		}
+	}
+
+	var results []string
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			results = append(results, typeString(field.Type))
+		}
+	}
+	return params, results
+}
+
+// paramList formats parameters for a function signature
+func paramList(params []param) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		parts[i] = p.name + " " + p.typ
+	}
+	return strings.Join(parts, ", ")
+}
+
+// resultList formats results for a function signature
+func resultList(results []string) string {
+	if len(results) <= 1 {
+		return strings.Join(results, "")
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// typeString formats a type expression, qualifying exported identifiers
+// declared in package ainative
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "ainative." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.ChanType:
+		switch t.Dir {
+		case ast.RECV:
+			return "<-chan " + typeString(t.Value)
+		case ast.SEND:
+			return "chan<- " + typeString(t.Value)
+		}
+		return "chan " + typeString(t.Value)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.IndexExpr:
+		return typeString(t.X) + "[" + typeString(t.Index) + "]"
+	}
+	log.Fatalf("unsupported type expression %T", expr)
+	return ""
+}
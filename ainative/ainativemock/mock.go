@@ -0,0 +1,83 @@
+// Package ainativemock provides mocks of the ainative service interfaces.
+//
+// Each mock has a Func field per method. A call invokes the field, or returns
+// an error wrapping ErrNotMocked when the field is nil, and is recorded so
+// tests can assert on the calls made.
+//
+// Example:
+//
+//	vectors := &ainativemock.VectorsAPI{
+//	    SearchFunc: func(ctx context.Context, projectID string, req *ainative.VectorSearchRequest) (*ainative.VectorSearchResponse, error) {
+//	        return &ainative.VectorSearchResponse{Matches: matches}, nil
+//	    },
+//	}
+//	handler := NewSearchHandler(vectors) // accepts an ainative.VectorsAPI
+//	// ...
+//	if calls := vectors.CallsTo("Search"); len(calls) != 1 {
+//	    t.Fatalf("expected one search, got %d", len(calls))
+//	}
+package ainativemock
+
+//go:generate go run gen.go
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotMocked is returned by a mock method whose Func field is nil
+var ErrNotMocked = errors.New("ainativemock: method not mocked")
+
+// Call is a recorded method call. Args holds every argument except the
+// context.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records the calls made to a mock. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns every recorded call, in order
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls to method, in order
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset discards the recorded calls
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// record appends a call
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// notMocked returns the error for a call to an unset Func field
+func notMocked(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotMocked, method)
+}
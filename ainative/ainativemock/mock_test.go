@@ -0,0 +1,94 @@
+package ainativemock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ainative/go-sdk/ainative"
+)
+
+// searchFirst is code under test that depends on a service interface
+func searchFirst(ctx context.Context, vectors ainative.VectorsAPI, projectID string, vector []float64) (string, error) {
+	result, err := vectors.Search(ctx, projectID, &ainative.VectorSearchRequest{Vector: vector, TopK: 1})
+	if err != nil {
+		return "", err
+	}
+	if len(result.Matches) == 0 {
+		return "", nil
+	}
+	return result.Matches[0].ID, nil
+}
+
+func TestMock_CallsFunc(t *testing.T) {
+	vectors := &VectorsAPI{
+		SearchFunc: func(ctx context.Context, projectID string, req *ainative.VectorSearchRequest) (*ainative.VectorSearchResponse, error) {
+			return &ainative.VectorSearchResponse{Matches: []ainative.VectorSearchMatch{{ID: "vec_1", Score: 0.9}}}, nil
+		},
+	}
+
+	id, err := searchFirst(context.Background(), vectors, "proj_1", []float64{0.1, 0.2})
+	require.NoError(t, err)
+	assert.Equal(t, "vec_1", id)
+
+	calls := vectors.CallsTo("Search")
+	require.Len(t, calls, 1)
+	require.Len(t, calls[0].Args, 2)
+	assert.Equal(t, "proj_1", calls[0].Args[0])
+	assert.Equal(t, 1, calls[0].Args[1].(*ainative.VectorSearchRequest).TopK)
+	assert.Empty(t, vectors.CallsTo("Upsert"))
+}
+
+func TestMock_NotMocked(t *testing.T) {
+	vectors := &VectorsAPI{}
+
+	_, err := searchFirst(context.Background(), vectors, "proj_1", []float64{0.1})
+	assert.True(t, errors.Is(err, ErrNotMocked))
+	assert.Contains(t, err.Error(), "VectorsAPI.Search")
+
+	swarm := &SwarmAPI{}
+	assert.True(t, errors.Is(swarm.Stop(context.Background(), "swarm_1"), ErrNotMocked))
+
+	pager := swarm.ListAll(context.Background(), nil)
+	assert.False(t, pager.Next())
+	assert.True(t, errors.Is(pager.Err(), ErrNotMocked))
+
+	assert.Len(t, swarm.Calls(), 2)
+	swarm.Reset()
+	assert.Empty(t, swarm.Calls())
+}
+
+func TestMock_Pager(t *testing.T) {
+	projects := &ProjectsAPI{
+		ListAllFunc: func(ctx context.Context, req *ainative.ListProjectsRequest) *ainative.Pager[ainative.Project] {
+			return ainative.NewSlicePager([]ainative.Project{{ID: "proj_1"}, {ID: "proj_2"}}, nil)
+		},
+	}
+
+	var projectsAPI ainative.ProjectsAPI = projects
+	items, err := projectsAPI.ListAll(context.Background(), nil).Collect()
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+}
+
+func TestMock_ClientServicesSatisfyInterfaces(t *testing.T) {
+	client, err := ainative.NewClient(&ainative.Config{APIKey: "test-key"})
+	require.NoError(t, err)
+
+	var (
+		_ ainative.ProjectsAPI      = client.ZeroDB.Projects
+		_ ainative.VectorsAPI       = client.ZeroDB.Vectors
+		_ ainative.NamespacesAPI    = client.ZeroDB.Namespaces
+		_ ainative.MemoryAPI        = client.ZeroDB.Memory
+		_ ainative.EmbeddingsAPI    = client.ZeroDB.Embeddings
+		_ ainative.SwarmAPI         = client.AgentSwarm
+		_ ainative.OrchestrationAPI = client.AgentOrchestration
+		_ ainative.CoordinationAPI  = client.AgentCoordination
+		_ ainative.LearningAPI      = client.AgentLearning
+		_ ainative.StateAPI         = client.AgentState
+		_ ainative.AuthAPI          = client.Auth
+	)
+}
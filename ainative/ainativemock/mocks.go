@@ -0,0 +1,842 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package ainativemock
+
+import (
+	"context"
+
+	"github.com/ainative/go-sdk/ainative"
+)
+
+// ProjectsAPI is a mock of ainative.ProjectsAPI
+type ProjectsAPI struct {
+	Recorder
+
+	CreateFunc   func(ctx context.Context, req *ainative.CreateProjectRequest) (*ainative.Project, error)
+	ListFunc     func(ctx context.Context, req *ainative.ListProjectsRequest) (*ainative.ListProjectsResponse, error)
+	ListAllFunc  func(ctx context.Context, req *ainative.ListProjectsRequest) *ainative.Pager[ainative.Project]
+	GetFunc      func(ctx context.Context, projectID string) (*ainative.Project, error)
+	UpdateFunc   func(ctx context.Context, projectID string, req *ainative.UpdateProjectRequest) (*ainative.Project, error)
+	SuspendFunc  func(ctx context.Context, projectID string, reason string) error
+	ActivateFunc func(ctx context.Context, projectID string) error
+	DeleteFunc   func(ctx context.Context, projectID string) error
+}
+
+var _ ainative.ProjectsAPI = (*ProjectsAPI)(nil)
+
+// Create calls CreateFunc, or fails with ErrNotMocked when it is nil
+func (m *ProjectsAPI) Create(ctx context.Context, req *ainative.CreateProjectRequest) (*ainative.Project, error) {
+	m.record("Create", req)
+	if m.CreateFunc == nil {
+		var r0 *ainative.Project
+		return r0, notMocked("ProjectsAPI.Create")
+	}
+	return m.CreateFunc(ctx, req)
+}
+
+// List calls ListFunc, or fails with ErrNotMocked when it is nil
+func (m *ProjectsAPI) List(ctx context.Context, req *ainative.ListProjectsRequest) (*ainative.ListProjectsResponse, error) {
+	m.record("List", req)
+	if m.ListFunc == nil {
+		var r0 *ainative.ListProjectsResponse
+		return r0, notMocked("ProjectsAPI.List")
+	}
+	return m.ListFunc(ctx, req)
+}
+
+// ListAll calls ListAllFunc, or fails with ErrNotMocked when it is nil
+func (m *ProjectsAPI) ListAll(ctx context.Context, req *ainative.ListProjectsRequest) *ainative.Pager[ainative.Project] {
+	m.record("ListAll", req)
+	if m.ListAllFunc == nil {
+		return ainative.NewSlicePager[ainative.Project](nil, notMocked("ProjectsAPI.ListAll"))
+	}
+	return m.ListAllFunc(ctx, req)
+}
+
+// Get calls GetFunc, or fails with ErrNotMocked when it is nil
+func (m *ProjectsAPI) Get(ctx context.Context, projectID string) (*ainative.Project, error) {
+	m.record("Get", projectID)
+	if m.GetFunc == nil {
+		var r0 *ainative.Project
+		return r0, notMocked("ProjectsAPI.Get")
+	}
+	return m.GetFunc(ctx, projectID)
+}
+
+// Update calls UpdateFunc, or fails with ErrNotMocked when it is nil
+func (m *ProjectsAPI) Update(ctx context.Context, projectID string, req *ainative.UpdateProjectRequest) (*ainative.Project, error) {
+	m.record("Update", projectID, req)
+	if m.UpdateFunc == nil {
+		var r0 *ainative.Project
+		return r0, notMocked("ProjectsAPI.Update")
+	}
+	return m.UpdateFunc(ctx, projectID, req)
+}
+
+// Suspend calls SuspendFunc, or fails with ErrNotMocked when it is nil
+func (m *ProjectsAPI) Suspend(ctx context.Context, projectID string, reason string) error {
+	m.record("Suspend", projectID, reason)
+	if m.SuspendFunc == nil {
+		return notMocked("ProjectsAPI.Suspend")
+	}
+	return m.SuspendFunc(ctx, projectID, reason)
+}
+
+// Activate calls ActivateFunc, or fails with ErrNotMocked when it is nil
+func (m *ProjectsAPI) Activate(ctx context.Context, projectID string) error {
+	m.record("Activate", projectID)
+	if m.ActivateFunc == nil {
+		return notMocked("ProjectsAPI.Activate")
+	}
+	return m.ActivateFunc(ctx, projectID)
+}
+
+// Delete calls DeleteFunc, or fails with ErrNotMocked when it is nil
+func (m *ProjectsAPI) Delete(ctx context.Context, projectID string) error {
+	m.record("Delete", projectID)
+	if m.DeleteFunc == nil {
+		return notMocked("ProjectsAPI.Delete")
+	}
+	return m.DeleteFunc(ctx, projectID)
+}
+
+// VectorsAPI is a mock of ainative.VectorsAPI
+type VectorsAPI struct {
+	Recorder
+
+	SearchFunc           func(ctx context.Context, projectID string, req *ainative.VectorSearchRequest) (*ainative.VectorSearchResponse, error)
+	UpsertFunc           func(ctx context.Context, projectID string, req *ainative.UpsertVectorsRequest) (*ainative.UpsertVectorsResponse, error)
+	FetchFunc            func(ctx context.Context, projectID string, req *ainative.FetchVectorsRequest) (*ainative.FetchVectorsResponse, error)
+	DeleteFunc           func(ctx context.Context, projectID string, req *ainative.DeleteVectorsRequest) (*ainative.DeleteVectorsResponse, error)
+	UpdateMetadataFunc   func(ctx context.Context, projectID string, vectorID string, req *ainative.UpdateVectorMetadataRequest) (*ainative.VectorItem, error)
+	ListIDsFunc          func(ctx context.Context, projectID string, req *ainative.ListVectorIDsRequest) (*ainative.ListVectorIDsResponse, error)
+	ListAllIDsFunc       func(ctx context.Context, projectID string, req *ainative.ListVectorIDsRequest) *ainative.Pager[string]
+	GetStatsFunc         func(ctx context.Context, projectID string, namespace string) (*ainative.VectorStats, error)
+	BulkUpsertFunc       func(ctx context.Context, projectID string, vectors []ainative.VectorItem, opts *ainative.BulkUpsertOptions) (*ainative.BulkUpsertResult, error)
+	BulkUpsertStreamFunc func(ctx context.Context, projectID string, vectors <-chan ainative.VectorItem, opts *ainative.BulkUpsertOptions) (*ainative.BulkUpsertResult, error)
+}
+
+var _ ainative.VectorsAPI = (*VectorsAPI)(nil)
+
+// Search calls SearchFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) Search(ctx context.Context, projectID string, req *ainative.VectorSearchRequest) (*ainative.VectorSearchResponse, error) {
+	m.record("Search", projectID, req)
+	if m.SearchFunc == nil {
+		var r0 *ainative.VectorSearchResponse
+		return r0, notMocked("VectorsAPI.Search")
+	}
+	return m.SearchFunc(ctx, projectID, req)
+}
+
+// Upsert calls UpsertFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) Upsert(ctx context.Context, projectID string, req *ainative.UpsertVectorsRequest) (*ainative.UpsertVectorsResponse, error) {
+	m.record("Upsert", projectID, req)
+	if m.UpsertFunc == nil {
+		var r0 *ainative.UpsertVectorsResponse
+		return r0, notMocked("VectorsAPI.Upsert")
+	}
+	return m.UpsertFunc(ctx, projectID, req)
+}
+
+// Fetch calls FetchFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) Fetch(ctx context.Context, projectID string, req *ainative.FetchVectorsRequest) (*ainative.FetchVectorsResponse, error) {
+	m.record("Fetch", projectID, req)
+	if m.FetchFunc == nil {
+		var r0 *ainative.FetchVectorsResponse
+		return r0, notMocked("VectorsAPI.Fetch")
+	}
+	return m.FetchFunc(ctx, projectID, req)
+}
+
+// Delete calls DeleteFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) Delete(ctx context.Context, projectID string, req *ainative.DeleteVectorsRequest) (*ainative.DeleteVectorsResponse, error) {
+	m.record("Delete", projectID, req)
+	if m.DeleteFunc == nil {
+		var r0 *ainative.DeleteVectorsResponse
+		return r0, notMocked("VectorsAPI.Delete")
+	}
+	return m.DeleteFunc(ctx, projectID, req)
+}
+
+// UpdateMetadata calls UpdateMetadataFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) UpdateMetadata(ctx context.Context, projectID string, vectorID string, req *ainative.UpdateVectorMetadataRequest) (*ainative.VectorItem, error) {
+	m.record("UpdateMetadata", projectID, vectorID, req)
+	if m.UpdateMetadataFunc == nil {
+		var r0 *ainative.VectorItem
+		return r0, notMocked("VectorsAPI.UpdateMetadata")
+	}
+	return m.UpdateMetadataFunc(ctx, projectID, vectorID, req)
+}
+
+// ListIDs calls ListIDsFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) ListIDs(ctx context.Context, projectID string, req *ainative.ListVectorIDsRequest) (*ainative.ListVectorIDsResponse, error) {
+	m.record("ListIDs", projectID, req)
+	if m.ListIDsFunc == nil {
+		var r0 *ainative.ListVectorIDsResponse
+		return r0, notMocked("VectorsAPI.ListIDs")
+	}
+	return m.ListIDsFunc(ctx, projectID, req)
+}
+
+// ListAllIDs calls ListAllIDsFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) ListAllIDs(ctx context.Context, projectID string, req *ainative.ListVectorIDsRequest) *ainative.Pager[string] {
+	m.record("ListAllIDs", projectID, req)
+	if m.ListAllIDsFunc == nil {
+		return ainative.NewSlicePager[string](nil, notMocked("VectorsAPI.ListAllIDs"))
+	}
+	return m.ListAllIDsFunc(ctx, projectID, req)
+}
+
+// GetStats calls GetStatsFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) GetStats(ctx context.Context, projectID string, namespace string) (*ainative.VectorStats, error) {
+	m.record("GetStats", projectID, namespace)
+	if m.GetStatsFunc == nil {
+		var r0 *ainative.VectorStats
+		return r0, notMocked("VectorsAPI.GetStats")
+	}
+	return m.GetStatsFunc(ctx, projectID, namespace)
+}
+
+// BulkUpsert calls BulkUpsertFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) BulkUpsert(ctx context.Context, projectID string, vectors []ainative.VectorItem, opts *ainative.BulkUpsertOptions) (*ainative.BulkUpsertResult, error) {
+	m.record("BulkUpsert", projectID, vectors, opts)
+	if m.BulkUpsertFunc == nil {
+		var r0 *ainative.BulkUpsertResult
+		return r0, notMocked("VectorsAPI.BulkUpsert")
+	}
+	return m.BulkUpsertFunc(ctx, projectID, vectors, opts)
+}
+
+// BulkUpsertStream calls BulkUpsertStreamFunc, or fails with ErrNotMocked when it is nil
+func (m *VectorsAPI) BulkUpsertStream(ctx context.Context, projectID string, vectors <-chan ainative.VectorItem, opts *ainative.BulkUpsertOptions) (*ainative.BulkUpsertResult, error) {
+	m.record("BulkUpsertStream", projectID, vectors, opts)
+	if m.BulkUpsertStreamFunc == nil {
+		var r0 *ainative.BulkUpsertResult
+		return r0, notMocked("VectorsAPI.BulkUpsertStream")
+	}
+	return m.BulkUpsertStreamFunc(ctx, projectID, vectors, opts)
+}
+
+// NamespacesAPI is a mock of ainative.NamespacesAPI
+type NamespacesAPI struct {
+	Recorder
+
+	ListFunc     func(ctx context.Context, projectID string, req *ainative.ListNamespacesRequest) (*ainative.ListNamespacesResponse, error)
+	ListAllFunc  func(ctx context.Context, projectID string, req *ainative.ListNamespacesRequest) *ainative.Pager[ainative.Namespace]
+	DescribeFunc func(ctx context.Context, projectID string, namespace string) (*ainative.Namespace, error)
+	DeleteFunc   func(ctx context.Context, projectID string, namespace string) (*ainative.DeleteVectorsResponse, error)
+	CopyFunc     func(ctx context.Context, projectID string, namespace string, req *ainative.CopyNamespaceRequest) (*ainative.Namespace, error)
+	RenameFunc   func(ctx context.Context, projectID string, namespace string, req *ainative.RenameNamespaceRequest) (*ainative.Namespace, error)
+}
+
+var _ ainative.NamespacesAPI = (*NamespacesAPI)(nil)
+
+// List calls ListFunc, or fails with ErrNotMocked when it is nil
+func (m *NamespacesAPI) List(ctx context.Context, projectID string, req *ainative.ListNamespacesRequest) (*ainative.ListNamespacesResponse, error) {
+	m.record("List", projectID, req)
+	if m.ListFunc == nil {
+		var r0 *ainative.ListNamespacesResponse
+		return r0, notMocked("NamespacesAPI.List")
+	}
+	return m.ListFunc(ctx, projectID, req)
+}
+
+// ListAll calls ListAllFunc, or fails with ErrNotMocked when it is nil
+func (m *NamespacesAPI) ListAll(ctx context.Context, projectID string, req *ainative.ListNamespacesRequest) *ainative.Pager[ainative.Namespace] {
+	m.record("ListAll", projectID, req)
+	if m.ListAllFunc == nil {
+		return ainative.NewSlicePager[ainative.Namespace](nil, notMocked("NamespacesAPI.ListAll"))
+	}
+	return m.ListAllFunc(ctx, projectID, req)
+}
+
+// Describe calls DescribeFunc, or fails with ErrNotMocked when it is nil
+func (m *NamespacesAPI) Describe(ctx context.Context, projectID string, namespace string) (*ainative.Namespace, error) {
+	m.record("Describe", projectID, namespace)
+	if m.DescribeFunc == nil {
+		var r0 *ainative.Namespace
+		return r0, notMocked("NamespacesAPI.Describe")
+	}
+	return m.DescribeFunc(ctx, projectID, namespace)
+}
+
+// Delete calls DeleteFunc, or fails with ErrNotMocked when it is nil
+func (m *NamespacesAPI) Delete(ctx context.Context, projectID string, namespace string) (*ainative.DeleteVectorsResponse, error) {
+	m.record("Delete", projectID, namespace)
+	if m.DeleteFunc == nil {
+		var r0 *ainative.DeleteVectorsResponse
+		return r0, notMocked("NamespacesAPI.Delete")
+	}
+	return m.DeleteFunc(ctx, projectID, namespace)
+}
+
+// Copy calls CopyFunc, or fails with ErrNotMocked when it is nil
+func (m *NamespacesAPI) Copy(ctx context.Context, projectID string, namespace string, req *ainative.CopyNamespaceRequest) (*ainative.Namespace, error) {
+	m.record("Copy", projectID, namespace, req)
+	if m.CopyFunc == nil {
+		var r0 *ainative.Namespace
+		return r0, notMocked("NamespacesAPI.Copy")
+	}
+	return m.CopyFunc(ctx, projectID, namespace, req)
+}
+
+// Rename calls RenameFunc, or fails with ErrNotMocked when it is nil
+func (m *NamespacesAPI) Rename(ctx context.Context, projectID string, namespace string, req *ainative.RenameNamespaceRequest) (*ainative.Namespace, error) {
+	m.record("Rename", projectID, namespace, req)
+	if m.RenameFunc == nil {
+		var r0 *ainative.Namespace
+		return r0, notMocked("NamespacesAPI.Rename")
+	}
+	return m.RenameFunc(ctx, projectID, namespace, req)
+}
+
+// MemoryAPI is a mock of ainative.MemoryAPI
+type MemoryAPI struct {
+	Recorder
+
+	CreateFunc func(ctx context.Context, req *ainative.CreateMemoryRequest) (*ainative.MemoryItem, error)
+	SearchFunc func(ctx context.Context, req *ainative.SearchMemoryRequest) (*ainative.SearchMemoryResponse, error)
+}
+
+var _ ainative.MemoryAPI = (*MemoryAPI)(nil)
+
+// Create calls CreateFunc, or fails with ErrNotMocked when it is nil
+func (m *MemoryAPI) Create(ctx context.Context, req *ainative.CreateMemoryRequest) (*ainative.MemoryItem, error) {
+	m.record("Create", req)
+	if m.CreateFunc == nil {
+		var r0 *ainative.MemoryItem
+		return r0, notMocked("MemoryAPI.Create")
+	}
+	return m.CreateFunc(ctx, req)
+}
+
+// Search calls SearchFunc, or fails with ErrNotMocked when it is nil
+func (m *MemoryAPI) Search(ctx context.Context, req *ainative.SearchMemoryRequest) (*ainative.SearchMemoryResponse, error) {
+	m.record("Search", req)
+	if m.SearchFunc == nil {
+		var r0 *ainative.SearchMemoryResponse
+		return r0, notMocked("MemoryAPI.Search")
+	}
+	return m.SearchFunc(ctx, req)
+}
+
+// EmbeddingsAPI is a mock of ainative.EmbeddingsAPI
+type EmbeddingsAPI struct {
+	Recorder
+
+	GenerateFunc       func(ctx context.Context, texts []string, model string, normalize bool) (*ainative.GenerateResponse, error)
+	EmbedAndStoreFunc  func(ctx context.Context, projectID string, texts []string, metadataList []map[string]interface{}, namespace string, model string) (*ainative.EmbedAndStoreResponse, error)
+	SemanticSearchFunc func(ctx context.Context, projectID string, query string, limit int, threshold float64, namespace string, filterMetadata map[string]interface{}, model string) (*ainative.SemanticSearchResponse, error)
+	ListModelsFunc     func(ctx context.Context) ([]ainative.EmbeddingModel, error)
+	HealthCheckFunc    func(ctx context.Context) (*ainative.HealthCheckResponse, error)
+	GetUsageFunc       func(ctx context.Context) (*ainative.UsageResponse, error)
+}
+
+var _ ainative.EmbeddingsAPI = (*EmbeddingsAPI)(nil)
+
+// Generate calls GenerateFunc, or fails with ErrNotMocked when it is nil
+func (m *EmbeddingsAPI) Generate(ctx context.Context, texts []string, model string, normalize bool) (*ainative.GenerateResponse, error) {
+	m.record("Generate", texts, model, normalize)
+	if m.GenerateFunc == nil {
+		var r0 *ainative.GenerateResponse
+		return r0, notMocked("EmbeddingsAPI.Generate")
+	}
+	return m.GenerateFunc(ctx, texts, model, normalize)
+}
+
+// EmbedAndStore calls EmbedAndStoreFunc, or fails with ErrNotMocked when it is nil
+func (m *EmbeddingsAPI) EmbedAndStore(ctx context.Context, projectID string, texts []string, metadataList []map[string]interface{}, namespace string, model string) (*ainative.EmbedAndStoreResponse, error) {
+	m.record("EmbedAndStore", projectID, texts, metadataList, namespace, model)
+	if m.EmbedAndStoreFunc == nil {
+		var r0 *ainative.EmbedAndStoreResponse
+		return r0, notMocked("EmbeddingsAPI.EmbedAndStore")
+	}
+	return m.EmbedAndStoreFunc(ctx, projectID, texts, metadataList, namespace, model)
+}
+
+// SemanticSearch calls SemanticSearchFunc, or fails with ErrNotMocked when it is nil
+func (m *EmbeddingsAPI) SemanticSearch(ctx context.Context, projectID string, query string, limit int, threshold float64, namespace string, filterMetadata map[string]interface{}, model string) (*ainative.SemanticSearchResponse, error) {
+	m.record("SemanticSearch", projectID, query, limit, threshold, namespace, filterMetadata, model)
+	if m.SemanticSearchFunc == nil {
+		var r0 *ainative.SemanticSearchResponse
+		return r0, notMocked("EmbeddingsAPI.SemanticSearch")
+	}
+	return m.SemanticSearchFunc(ctx, projectID, query, limit, threshold, namespace, filterMetadata, model)
+}
+
+// ListModels calls ListModelsFunc, or fails with ErrNotMocked when it is nil
+func (m *EmbeddingsAPI) ListModels(ctx context.Context) ([]ainative.EmbeddingModel, error) {
+	m.record("ListModels")
+	if m.ListModelsFunc == nil {
+		var r0 []ainative.EmbeddingModel
+		return r0, notMocked("EmbeddingsAPI.ListModels")
+	}
+	return m.ListModelsFunc(ctx)
+}
+
+// HealthCheck calls HealthCheckFunc, or fails with ErrNotMocked when it is nil
+func (m *EmbeddingsAPI) HealthCheck(ctx context.Context) (*ainative.HealthCheckResponse, error) {
+	m.record("HealthCheck")
+	if m.HealthCheckFunc == nil {
+		var r0 *ainative.HealthCheckResponse
+		return r0, notMocked("EmbeddingsAPI.HealthCheck")
+	}
+	return m.HealthCheckFunc(ctx)
+}
+
+// GetUsage calls GetUsageFunc, or fails with ErrNotMocked when it is nil
+func (m *EmbeddingsAPI) GetUsage(ctx context.Context) (*ainative.UsageResponse, error) {
+	m.record("GetUsage")
+	if m.GetUsageFunc == nil {
+		var r0 *ainative.UsageResponse
+		return r0, notMocked("EmbeddingsAPI.GetUsage")
+	}
+	return m.GetUsageFunc(ctx)
+}
+
+// SwarmAPI is a mock of ainative.SwarmAPI
+type SwarmAPI struct {
+	Recorder
+
+	StartFunc           func(ctx context.Context, req *ainative.StartSwarmRequest) (*ainative.AgentSwarm, error)
+	GetFunc             func(ctx context.Context, swarmID string) (*ainative.AgentSwarm, error)
+	ListFunc            func(ctx context.Context, req *ainative.ListSwarmsRequest) (*ainative.ListSwarmsResponse, error)
+	ListAllFunc         func(ctx context.Context, req *ainative.ListSwarmsRequest) *ainative.Pager[ainative.AgentSwarm]
+	StopFunc            func(ctx context.Context, swarmID string) error
+	PauseFunc           func(ctx context.Context, swarmID string) error
+	ResumeFunc          func(ctx context.Context, swarmID string) error
+	OrchestrateFunc     func(ctx context.Context, req *ainative.OrchestrationRequest) (*ainative.OrchestrationResponse, error)
+	GetTaskFunc         func(ctx context.Context, taskID string) (*ainative.OrchestrationTask, error)
+	ListAgentTypesFunc  func(ctx context.Context) ([]ainative.AgentType, error)
+	GetSwarmMetricsFunc func(ctx context.Context, swarmID string) (*ainative.SwarmMetrics, error)
+}
+
+var _ ainative.SwarmAPI = (*SwarmAPI)(nil)
+
+// Start calls StartFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) Start(ctx context.Context, req *ainative.StartSwarmRequest) (*ainative.AgentSwarm, error) {
+	m.record("Start", req)
+	if m.StartFunc == nil {
+		var r0 *ainative.AgentSwarm
+		return r0, notMocked("SwarmAPI.Start")
+	}
+	return m.StartFunc(ctx, req)
+}
+
+// Get calls GetFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) Get(ctx context.Context, swarmID string) (*ainative.AgentSwarm, error) {
+	m.record("Get", swarmID)
+	if m.GetFunc == nil {
+		var r0 *ainative.AgentSwarm
+		return r0, notMocked("SwarmAPI.Get")
+	}
+	return m.GetFunc(ctx, swarmID)
+}
+
+// List calls ListFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) List(ctx context.Context, req *ainative.ListSwarmsRequest) (*ainative.ListSwarmsResponse, error) {
+	m.record("List", req)
+	if m.ListFunc == nil {
+		var r0 *ainative.ListSwarmsResponse
+		return r0, notMocked("SwarmAPI.List")
+	}
+	return m.ListFunc(ctx, req)
+}
+
+// ListAll calls ListAllFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) ListAll(ctx context.Context, req *ainative.ListSwarmsRequest) *ainative.Pager[ainative.AgentSwarm] {
+	m.record("ListAll", req)
+	if m.ListAllFunc == nil {
+		return ainative.NewSlicePager[ainative.AgentSwarm](nil, notMocked("SwarmAPI.ListAll"))
+	}
+	return m.ListAllFunc(ctx, req)
+}
+
+// Stop calls StopFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) Stop(ctx context.Context, swarmID string) error {
+	m.record("Stop", swarmID)
+	if m.StopFunc == nil {
+		return notMocked("SwarmAPI.Stop")
+	}
+	return m.StopFunc(ctx, swarmID)
+}
+
+// Pause calls PauseFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) Pause(ctx context.Context, swarmID string) error {
+	m.record("Pause", swarmID)
+	if m.PauseFunc == nil {
+		return notMocked("SwarmAPI.Pause")
+	}
+	return m.PauseFunc(ctx, swarmID)
+}
+
+// Resume calls ResumeFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) Resume(ctx context.Context, swarmID string) error {
+	m.record("Resume", swarmID)
+	if m.ResumeFunc == nil {
+		return notMocked("SwarmAPI.Resume")
+	}
+	return m.ResumeFunc(ctx, swarmID)
+}
+
+// Orchestrate calls OrchestrateFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) Orchestrate(ctx context.Context, req *ainative.OrchestrationRequest) (*ainative.OrchestrationResponse, error) {
+	m.record("Orchestrate", req)
+	if m.OrchestrateFunc == nil {
+		var r0 *ainative.OrchestrationResponse
+		return r0, notMocked("SwarmAPI.Orchestrate")
+	}
+	return m.OrchestrateFunc(ctx, req)
+}
+
+// GetTask calls GetTaskFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) GetTask(ctx context.Context, taskID string) (*ainative.OrchestrationTask, error) {
+	m.record("GetTask", taskID)
+	if m.GetTaskFunc == nil {
+		var r0 *ainative.OrchestrationTask
+		return r0, notMocked("SwarmAPI.GetTask")
+	}
+	return m.GetTaskFunc(ctx, taskID)
+}
+
+// ListAgentTypes calls ListAgentTypesFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) ListAgentTypes(ctx context.Context) ([]ainative.AgentType, error) {
+	m.record("ListAgentTypes")
+	if m.ListAgentTypesFunc == nil {
+		var r0 []ainative.AgentType
+		return r0, notMocked("SwarmAPI.ListAgentTypes")
+	}
+	return m.ListAgentTypesFunc(ctx)
+}
+
+// GetSwarmMetrics calls GetSwarmMetricsFunc, or fails with ErrNotMocked when it is nil
+func (m *SwarmAPI) GetSwarmMetrics(ctx context.Context, swarmID string) (*ainative.SwarmMetrics, error) {
+	m.record("GetSwarmMetrics", swarmID)
+	if m.GetSwarmMetricsFunc == nil {
+		var r0 *ainative.SwarmMetrics
+		return r0, notMocked("SwarmAPI.GetSwarmMetrics")
+	}
+	return m.GetSwarmMetricsFunc(ctx, swarmID)
+}
+
+// OrchestrationAPI is a mock of ainative.OrchestrationAPI
+type OrchestrationAPI struct {
+	Recorder
+
+	CreateTaskFunc         func(ctx context.Context, req *ainative.CreateTaskRequest) (*ainative.CreateTaskResponse, error)
+	ListTasksFunc          func(ctx context.Context, req *ainative.ListTasksRequest) (*ainative.ListTasksResponse, error)
+	ListAllTasksFunc       func(ctx context.Context, req *ainative.ListTasksRequest) *ainative.Pager[ainative.Task]
+	GetTaskStatusFunc      func(ctx context.Context, taskID string) (*ainative.TaskStatusResponse, error)
+	ExecuteTaskFunc        func(ctx context.Context, req *ainative.ExecuteTaskRequest) (*ainative.ExecuteTaskResponse, error)
+	CreateTaskSequenceFunc func(ctx context.Context, req *ainative.CreateTaskSequenceRequest) (*ainative.CreateTaskSequenceResponse, error)
+}
+
+var _ ainative.OrchestrationAPI = (*OrchestrationAPI)(nil)
+
+// CreateTask calls CreateTaskFunc, or fails with ErrNotMocked when it is nil
+func (m *OrchestrationAPI) CreateTask(ctx context.Context, req *ainative.CreateTaskRequest) (*ainative.CreateTaskResponse, error) {
+	m.record("CreateTask", req)
+	if m.CreateTaskFunc == nil {
+		var r0 *ainative.CreateTaskResponse
+		return r0, notMocked("OrchestrationAPI.CreateTask")
+	}
+	return m.CreateTaskFunc(ctx, req)
+}
+
+// ListTasks calls ListTasksFunc, or fails with ErrNotMocked when it is nil
+func (m *OrchestrationAPI) ListTasks(ctx context.Context, req *ainative.ListTasksRequest) (*ainative.ListTasksResponse, error) {
+	m.record("ListTasks", req)
+	if m.ListTasksFunc == nil {
+		var r0 *ainative.ListTasksResponse
+		return r0, notMocked("OrchestrationAPI.ListTasks")
+	}
+	return m.ListTasksFunc(ctx, req)
+}
+
+// ListAllTasks calls ListAllTasksFunc, or fails with ErrNotMocked when it is nil
+func (m *OrchestrationAPI) ListAllTasks(ctx context.Context, req *ainative.ListTasksRequest) *ainative.Pager[ainative.Task] {
+	m.record("ListAllTasks", req)
+	if m.ListAllTasksFunc == nil {
+		return ainative.NewSlicePager[ainative.Task](nil, notMocked("OrchestrationAPI.ListAllTasks"))
+	}
+	return m.ListAllTasksFunc(ctx, req)
+}
+
+// GetTaskStatus calls GetTaskStatusFunc, or fails with ErrNotMocked when it is nil
+func (m *OrchestrationAPI) GetTaskStatus(ctx context.Context, taskID string) (*ainative.TaskStatusResponse, error) {
+	m.record("GetTaskStatus", taskID)
+	if m.GetTaskStatusFunc == nil {
+		var r0 *ainative.TaskStatusResponse
+		return r0, notMocked("OrchestrationAPI.GetTaskStatus")
+	}
+	return m.GetTaskStatusFunc(ctx, taskID)
+}
+
+// ExecuteTask calls ExecuteTaskFunc, or fails with ErrNotMocked when it is nil
+func (m *OrchestrationAPI) ExecuteTask(ctx context.Context, req *ainative.ExecuteTaskRequest) (*ainative.ExecuteTaskResponse, error) {
+	m.record("ExecuteTask", req)
+	if m.ExecuteTaskFunc == nil {
+		var r0 *ainative.ExecuteTaskResponse
+		return r0, notMocked("OrchestrationAPI.ExecuteTask")
+	}
+	return m.ExecuteTaskFunc(ctx, req)
+}
+
+// CreateTaskSequence calls CreateTaskSequenceFunc, or fails with ErrNotMocked when it is nil
+func (m *OrchestrationAPI) CreateTaskSequence(ctx context.Context, req *ainative.CreateTaskSequenceRequest) (*ainative.CreateTaskSequenceResponse, error) {
+	m.record("CreateTaskSequence", req)
+	if m.CreateTaskSequenceFunc == nil {
+		var r0 *ainative.CreateTaskSequenceResponse
+		return r0, notMocked("OrchestrationAPI.CreateTaskSequence")
+	}
+	return m.CreateTaskSequenceFunc(ctx, req)
+}
+
+// CoordinationAPI is a mock of ainative.CoordinationAPI
+type CoordinationAPI struct {
+	Recorder
+
+	SendMessageFunc      func(ctx context.Context, req *ainative.SendMessageRequest) (*ainative.SendMessageResponse, error)
+	DistributeTasksFunc  func(ctx context.Context, req *ainative.DistributeTasksRequest) (*ainative.DistributeTasksResponse, error)
+	GetWorkloadStatsFunc func(ctx context.Context, req *ainative.GetWorkloadStatsRequest) (*ainative.GetWorkloadStatsResponse, error)
+}
+
+var _ ainative.CoordinationAPI = (*CoordinationAPI)(nil)
+
+// SendMessage calls SendMessageFunc, or fails with ErrNotMocked when it is nil
+func (m *CoordinationAPI) SendMessage(ctx context.Context, req *ainative.SendMessageRequest) (*ainative.SendMessageResponse, error) {
+	m.record("SendMessage", req)
+	if m.SendMessageFunc == nil {
+		var r0 *ainative.SendMessageResponse
+		return r0, notMocked("CoordinationAPI.SendMessage")
+	}
+	return m.SendMessageFunc(ctx, req)
+}
+
+// DistributeTasks calls DistributeTasksFunc, or fails with ErrNotMocked when it is nil
+func (m *CoordinationAPI) DistributeTasks(ctx context.Context, req *ainative.DistributeTasksRequest) (*ainative.DistributeTasksResponse, error) {
+	m.record("DistributeTasks", req)
+	if m.DistributeTasksFunc == nil {
+		var r0 *ainative.DistributeTasksResponse
+		return r0, notMocked("CoordinationAPI.DistributeTasks")
+	}
+	return m.DistributeTasksFunc(ctx, req)
+}
+
+// GetWorkloadStats calls GetWorkloadStatsFunc, or fails with ErrNotMocked when it is nil
+func (m *CoordinationAPI) GetWorkloadStats(ctx context.Context, req *ainative.GetWorkloadStatsRequest) (*ainative.GetWorkloadStatsResponse, error) {
+	m.record("GetWorkloadStats", req)
+	if m.GetWorkloadStatsFunc == nil {
+		var r0 *ainative.GetWorkloadStatsResponse
+		return r0, notMocked("CoordinationAPI.GetWorkloadStats")
+	}
+	return m.GetWorkloadStatsFunc(ctx, req)
+}
+
+// LearningAPI is a mock of ainative.LearningAPI
+type LearningAPI struct {
+	Recorder
+
+	SubmitFeedbackFunc        func(ctx context.Context, req *ainative.SubmitFeedbackRequest) (*ainative.SubmitFeedbackResponse, error)
+	GetPerformanceMetricsFunc func(ctx context.Context, req *ainative.GetPerformanceMetricsRequest) (*ainative.GetPerformanceMetricsResponse, error)
+	CompareAgentsFunc         func(ctx context.Context, req *ainative.CompareAgentsRequest) (*ainative.CompareAgentsResponse, error)
+}
+
+var _ ainative.LearningAPI = (*LearningAPI)(nil)
+
+// SubmitFeedback calls SubmitFeedbackFunc, or fails with ErrNotMocked when it is nil
+func (m *LearningAPI) SubmitFeedback(ctx context.Context, req *ainative.SubmitFeedbackRequest) (*ainative.SubmitFeedbackResponse, error) {
+	m.record("SubmitFeedback", req)
+	if m.SubmitFeedbackFunc == nil {
+		var r0 *ainative.SubmitFeedbackResponse
+		return r0, notMocked("LearningAPI.SubmitFeedback")
+	}
+	return m.SubmitFeedbackFunc(ctx, req)
+}
+
+// GetPerformanceMetrics calls GetPerformanceMetricsFunc, or fails with ErrNotMocked when it is nil
+func (m *LearningAPI) GetPerformanceMetrics(ctx context.Context, req *ainative.GetPerformanceMetricsRequest) (*ainative.GetPerformanceMetricsResponse, error) {
+	m.record("GetPerformanceMetrics", req)
+	if m.GetPerformanceMetricsFunc == nil {
+		var r0 *ainative.GetPerformanceMetricsResponse
+		return r0, notMocked("LearningAPI.GetPerformanceMetrics")
+	}
+	return m.GetPerformanceMetricsFunc(ctx, req)
+}
+
+// CompareAgents calls CompareAgentsFunc, or fails with ErrNotMocked when it is nil
+func (m *LearningAPI) CompareAgents(ctx context.Context, req *ainative.CompareAgentsRequest) (*ainative.CompareAgentsResponse, error) {
+	m.record("CompareAgents", req)
+	if m.CompareAgentsFunc == nil {
+		var r0 *ainative.CompareAgentsResponse
+		return r0, notMocked("LearningAPI.CompareAgents")
+	}
+	return m.CompareAgentsFunc(ctx, req)
+}
+
+// StateAPI is a mock of ainative.StateAPI
+type StateAPI struct {
+	Recorder
+
+	GetStateFunc           func(ctx context.Context, req *ainative.GetStateRequest) (*ainative.GetStateResponse, error)
+	CreateCheckpointFunc   func(ctx context.Context, req *ainative.CreateCheckpointRequest) (*ainative.CreateCheckpointResponse, error)
+	RestoreCheckpointFunc  func(ctx context.Context, req *ainative.RestoreCheckpointRequest) (*ainative.RestoreCheckpointResponse, error)
+	ListCheckpointsFunc    func(ctx context.Context, req *ainative.ListCheckpointsRequest) (*ainative.ListCheckpointsResponse, error)
+	ListAllCheckpointsFunc func(ctx context.Context, req *ainative.ListCheckpointsRequest) *ainative.Pager[ainative.Checkpoint]
+}
+
+var _ ainative.StateAPI = (*StateAPI)(nil)
+
+// GetState calls GetStateFunc, or fails with ErrNotMocked when it is nil
+func (m *StateAPI) GetState(ctx context.Context, req *ainative.GetStateRequest) (*ainative.GetStateResponse, error) {
+	m.record("GetState", req)
+	if m.GetStateFunc == nil {
+		var r0 *ainative.GetStateResponse
+		return r0, notMocked("StateAPI.GetState")
+	}
+	return m.GetStateFunc(ctx, req)
+}
+
+// CreateCheckpoint calls CreateCheckpointFunc, or fails with ErrNotMocked when it is nil
+func (m *StateAPI) CreateCheckpoint(ctx context.Context, req *ainative.CreateCheckpointRequest) (*ainative.CreateCheckpointResponse, error) {
+	m.record("CreateCheckpoint", req)
+	if m.CreateCheckpointFunc == nil {
+		var r0 *ainative.CreateCheckpointResponse
+		return r0, notMocked("StateAPI.CreateCheckpoint")
+	}
+	return m.CreateCheckpointFunc(ctx, req)
+}
+
+// RestoreCheckpoint calls RestoreCheckpointFunc, or fails with ErrNotMocked when it is nil
+func (m *StateAPI) RestoreCheckpoint(ctx context.Context, req *ainative.RestoreCheckpointRequest) (*ainative.RestoreCheckpointResponse, error) {
+	m.record("RestoreCheckpoint", req)
+	if m.RestoreCheckpointFunc == nil {
+		var r0 *ainative.RestoreCheckpointResponse
+		return r0, notMocked("StateAPI.RestoreCheckpoint")
+	}
+	return m.RestoreCheckpointFunc(ctx, req)
+}
+
+// ListCheckpoints calls ListCheckpointsFunc, or fails with ErrNotMocked when it is nil
+func (m *StateAPI) ListCheckpoints(ctx context.Context, req *ainative.ListCheckpointsRequest) (*ainative.ListCheckpointsResponse, error) {
+	m.record("ListCheckpoints", req)
+	if m.ListCheckpointsFunc == nil {
+		var r0 *ainative.ListCheckpointsResponse
+		return r0, notMocked("StateAPI.ListCheckpoints")
+	}
+	return m.ListCheckpointsFunc(ctx, req)
+}
+
+// ListAllCheckpoints calls ListAllCheckpointsFunc, or fails with ErrNotMocked when it is nil
+func (m *StateAPI) ListAllCheckpoints(ctx context.Context, req *ainative.ListCheckpointsRequest) *ainative.Pager[ainative.Checkpoint] {
+	m.record("ListAllCheckpoints", req)
+	if m.ListAllCheckpointsFunc == nil {
+		return ainative.NewSlicePager[ainative.Checkpoint](nil, notMocked("StateAPI.ListAllCheckpoints"))
+	}
+	return m.ListAllCheckpointsFunc(ctx, req)
+}
+
+// AuthAPI is a mock of ainative.AuthAPI
+type AuthAPI struct {
+	Recorder
+
+	LoginFunc         func(ctx context.Context, req *ainative.LoginRequest) (*ainative.TokenResponse, error)
+	RefreshTokenFunc  func(ctx context.Context, req *ainative.RefreshTokenRequest) (*ainative.TokenResponse, error)
+	GetUserInfoFunc   func(ctx context.Context) (*ainative.UserInfo, error)
+	ListAPIKeysFunc   func(ctx context.Context) ([]ainative.APIKeyInfo, error)
+	CreateAPIKeyFunc  func(ctx context.Context, req *ainative.CreateAPIKeyRequest) (*ainative.CreateAPIKeyResponse, error)
+	RevokeAPIKeyFunc  func(ctx context.Context, keyID string) error
+	GetAPIKeyInfoFunc func(ctx context.Context, keyID string) (*ainative.APIKeyInfo, error)
+	ValidateTokenFunc func(ctx context.Context, token string) (*ainative.UserInfo, error)
+	LogoutFunc        func(ctx context.Context) error
+}
+
+var _ ainative.AuthAPI = (*AuthAPI)(nil)
+
+// Login calls LoginFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) Login(ctx context.Context, req *ainative.LoginRequest) (*ainative.TokenResponse, error) {
+	m.record("Login", req)
+	if m.LoginFunc == nil {
+		var r0 *ainative.TokenResponse
+		return r0, notMocked("AuthAPI.Login")
+	}
+	return m.LoginFunc(ctx, req)
+}
+
+// RefreshToken calls RefreshTokenFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) RefreshToken(ctx context.Context, req *ainative.RefreshTokenRequest) (*ainative.TokenResponse, error) {
+	m.record("RefreshToken", req)
+	if m.RefreshTokenFunc == nil {
+		var r0 *ainative.TokenResponse
+		return r0, notMocked("AuthAPI.RefreshToken")
+	}
+	return m.RefreshTokenFunc(ctx, req)
+}
+
+// GetUserInfo calls GetUserInfoFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) GetUserInfo(ctx context.Context) (*ainative.UserInfo, error) {
+	m.record("GetUserInfo")
+	if m.GetUserInfoFunc == nil {
+		var r0 *ainative.UserInfo
+		return r0, notMocked("AuthAPI.GetUserInfo")
+	}
+	return m.GetUserInfoFunc(ctx)
+}
+
+// ListAPIKeys calls ListAPIKeysFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) ListAPIKeys(ctx context.Context) ([]ainative.APIKeyInfo, error) {
+	m.record("ListAPIKeys")
+	if m.ListAPIKeysFunc == nil {
+		var r0 []ainative.APIKeyInfo
+		return r0, notMocked("AuthAPI.ListAPIKeys")
+	}
+	return m.ListAPIKeysFunc(ctx)
+}
+
+// CreateAPIKey calls CreateAPIKeyFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) CreateAPIKey(ctx context.Context, req *ainative.CreateAPIKeyRequest) (*ainative.CreateAPIKeyResponse, error) {
+	m.record("CreateAPIKey", req)
+	if m.CreateAPIKeyFunc == nil {
+		var r0 *ainative.CreateAPIKeyResponse
+		return r0, notMocked("AuthAPI.CreateAPIKey")
+	}
+	return m.CreateAPIKeyFunc(ctx, req)
+}
+
+// RevokeAPIKey calls RevokeAPIKeyFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) RevokeAPIKey(ctx context.Context, keyID string) error {
+	m.record("RevokeAPIKey", keyID)
+	if m.RevokeAPIKeyFunc == nil {
+		return notMocked("AuthAPI.RevokeAPIKey")
+	}
+	return m.RevokeAPIKeyFunc(ctx, keyID)
+}
+
+// GetAPIKeyInfo calls GetAPIKeyInfoFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) GetAPIKeyInfo(ctx context.Context, keyID string) (*ainative.APIKeyInfo, error) {
+	m.record("GetAPIKeyInfo", keyID)
+	if m.GetAPIKeyInfoFunc == nil {
+		var r0 *ainative.APIKeyInfo
+		return r0, notMocked("AuthAPI.GetAPIKeyInfo")
+	}
+	return m.GetAPIKeyInfoFunc(ctx, keyID)
+}
+
+// ValidateToken calls ValidateTokenFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) ValidateToken(ctx context.Context, token string) (*ainative.UserInfo, error) {
+	m.record("ValidateToken", token)
+	if m.ValidateTokenFunc == nil {
+		var r0 *ainative.UserInfo
+		return r0, notMocked("AuthAPI.ValidateToken")
+	}
+	return m.ValidateTokenFunc(ctx, token)
+}
+
+// Logout calls LogoutFunc, or fails with ErrNotMocked when it is nil
+func (m *AuthAPI) Logout(ctx context.Context) error {
+	m.record("Logout")
+	if m.LogoutFunc == nil {
+		return notMocked("AuthAPI.Logout")
+	}
+	return m.LogoutFunc(ctx)
+}
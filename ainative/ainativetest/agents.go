@@ -0,0 +1,794 @@
+package ainativetest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ainative/go-sdk/ainative"
+)
+
+// agentTypes are the agent types reported by the fake server
+var agentTypes = []ainative.AgentType{
+	ainative.AgentTypeAnalyzer,
+	ainative.AgentTypeGenerator,
+	ainative.AgentTypeOptimizer,
+	ainative.AgentTypeValidator,
+	ainative.AgentTypeCoordinator,
+	ainative.AgentTypeSecurityScanner,
+	ainative.AgentTypeCodeReviewer,
+	ainative.AgentTypeDocumentWriter,
+}
+
+// findSwarm returns the swarm with id; callers must hold s.mu
+func (s *Server) findSwarm(id string) *ainative.AgentSwarm {
+	for _, swarm := range s.swarms {
+		if swarm.ID == id {
+			return swarm
+		}
+	}
+	return nil
+}
+
+// swarmOr404 returns the swarm with id, writing a 404 if it is missing
+func (s *Server) swarmOr404(w http.ResponseWriter, id string) *ainative.AgentSwarm {
+	swarm := s.findSwarm(id)
+	if swarm == nil {
+		writeError(w, http.StatusNotFound, "Swarm not found")
+	}
+	return swarm
+}
+
+// startSwarm handles POST /api/v1/agent-swarm/swarms
+func (s *Server) startSwarm(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.StartSwarmRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	switch {
+	case req.ProjectID == "":
+		writeValidationError(w, "project_id", "field required")
+		return
+	case req.Objective == "":
+		writeValidationError(w, "objective", "field required")
+		return
+	case len(req.Agents) == 0:
+		writeValidationError(w, "agents", "ensure this value has at least 1 item")
+		return
+	}
+
+	created := now()
+	swarm := &ainative.AgentSwarm{
+		ID:        s.newID("swarm"),
+		ProjectID: req.ProjectID,
+		Name:      req.Name,
+		Objective: req.Objective,
+		Status:    ainative.SwarmStatusRunning,
+		Metrics:   &ainative.SwarmMetrics{},
+		Config:    req.Config,
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+	for _, config := range req.Agents {
+		count := config.Count
+		if count <= 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			swarm.Agents = append(swarm.Agents, ainative.Agent{
+				ID:           s.newID("agent"),
+				Type:         config.Type,
+				Status:       ainative.AgentStatusIdle,
+				Capabilities: config.Capabilities,
+				Config:       config.Config,
+				CreatedAt:    created,
+				UpdatedAt:    created,
+			})
+		}
+	}
+	s.swarms = append(s.swarms, swarm)
+
+	writeJSON(w, http.StatusCreated, swarm)
+}
+
+// listSwarms handles GET /api/v1/agent-swarm/swarms
+func (s *Server) listSwarms(w http.ResponseWriter, r *http.Request, _ []string) {
+	projectID := r.URL.Query().Get("project_id")
+	status := ainative.SwarmStatus(r.URL.Query().Get("status"))
+
+	var swarms []ainative.AgentSwarm
+	for _, swarm := range s.swarms {
+		if (projectID == "" || swarm.ProjectID == projectID) && (status == "" || swarm.Status == status) {
+			swarms = append(swarms, *swarm)
+		}
+	}
+
+	limit, offset := pageBounds(r)
+	writeJSON(w, http.StatusOK, ainative.ListSwarmsResponse{
+		Swarms:     paginate(swarms, limit, offset),
+		TotalCount: len(swarms),
+		Limit:      limit,
+		Offset:     offset,
+	})
+}
+
+// getSwarm handles GET /api/v1/agent-swarm/swarms/{id}
+func (s *Server) getSwarm(w http.ResponseWriter, r *http.Request, params []string) {
+	if swarm := s.swarmOr404(w, params[0]); swarm != nil {
+		writeJSON(w, http.StatusOK, swarm)
+	}
+}
+
+// stopSwarm handles POST /api/v1/agent-swarm/swarms/{id}/stop
+func (s *Server) stopSwarm(w http.ResponseWriter, r *http.Request, params []string) {
+	s.transitionSwarm(w, params[0], ainative.SwarmStatusStopped,
+		ainative.SwarmStatusInitializing, ainative.SwarmStatusRunning, ainative.SwarmStatusPaused)
+}
+
+// pauseSwarm handles POST /api/v1/agent-swarm/swarms/{id}/pause
+func (s *Server) pauseSwarm(w http.ResponseWriter, r *http.Request, params []string) {
+	s.transitionSwarm(w, params[0], ainative.SwarmStatusPaused, ainative.SwarmStatusRunning)
+}
+
+// resumeSwarm handles POST /api/v1/agent-swarm/swarms/{id}/resume
+func (s *Server) resumeSwarm(w http.ResponseWriter, r *http.Request, params []string) {
+	s.transitionSwarm(w, params[0], ainative.SwarmStatusRunning, ainative.SwarmStatusPaused)
+}
+
+// transitionSwarm moves a swarm to status, writing a 409 unless its current
+// status is one of from
+func (s *Server) transitionSwarm(w http.ResponseWriter, id string, status ainative.SwarmStatus, from ...ainative.SwarmStatus) {
+	swarm := s.swarmOr404(w, id)
+	if swarm == nil {
+		return
+	}
+
+	allowed := false
+	for _, f := range from {
+		allowed = allowed || swarm.Status == f
+	}
+	if !allowed {
+		writeError(w, http.StatusConflict, "Swarm is "+string(swarm.Status))
+		return
+	}
+
+	swarm.Status = status
+	swarm.UpdatedAt = now()
+	if status == ainative.SwarmStatusStopped {
+		completed := swarm.UpdatedAt
+		swarm.CompletedAt = &completed
+	}
+
+	writeJSON(w, http.StatusOK, swarm)
+}
+
+// swarmMetrics handles GET /api/v1/agent-swarm/swarms/{id}/metrics
+func (s *Server) swarmMetrics(w http.ResponseWriter, r *http.Request, params []string) {
+	swarm := s.swarmOr404(w, params[0])
+	if swarm == nil {
+		return
+	}
+
+	metrics := ainative.SwarmMetrics{}
+	for _, task := range s.swarmTasks {
+		if task.SwarmID != swarm.ID {
+			continue
+		}
+		switch task.Status {
+		case ainative.TaskStatusCompleted:
+			metrics.TasksCompleted++
+		case ainative.TaskStatusFailed:
+			metrics.TasksFailed++
+		default:
+			metrics.TasksInProgress++
+		}
+	}
+	if finished := metrics.TasksCompleted + metrics.TasksFailed; finished > 0 {
+		metrics.Efficiency = float64(metrics.TasksCompleted) / float64(finished)
+	}
+
+	writeJSON(w, http.StatusOK, metrics)
+}
+
+// orchestrate handles POST /api/v1/agent-swarm/orchestrate
+func (s *Server) orchestrate(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.OrchestrationRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Task == "" {
+		writeValidationError(w, "task", "field required")
+		return
+	}
+
+	swarm := s.swarmOr404(w, req.SwarmID)
+	if swarm == nil {
+		return
+	}
+	if swarm.Status != ainative.SwarmStatusRunning {
+		writeError(w, http.StatusConflict, "Swarm is "+string(swarm.Status))
+		return
+	}
+
+	assigned := req.AgentIDs
+	if len(assigned) == 0 {
+		for _, agent := range swarm.Agents {
+			assigned = append(assigned, agent.ID)
+		}
+	}
+	if req.Priority == "" {
+		req.Priority = ainative.TaskPriorityMedium
+	}
+
+	task := &ainative.OrchestrationTask{
+		ID:          s.newID("swarm_task"),
+		SwarmID:     swarm.ID,
+		Type:        "orchestration",
+		Description: req.Task,
+		Context:     req.Context,
+		Priority:    req.Priority,
+		Status:      ainative.TaskStatusAssigned,
+		AssignedTo:  assigned,
+		CreatedAt:   now(),
+	}
+	s.swarmTasks = append(s.swarmTasks, task)
+
+	writeJSON(w, http.StatusOK, ainative.OrchestrationResponse{
+		TaskID:     task.ID,
+		Status:     task.Status,
+		AssignedTo: task.AssignedTo,
+	})
+}
+
+// getSwarmTask handles GET /api/v1/agent-swarm/tasks/{id}
+func (s *Server) getSwarmTask(w http.ResponseWriter, r *http.Request, params []string) {
+	for _, task := range s.swarmTasks {
+		if task.ID == params[0] {
+			writeJSON(w, http.StatusOK, task)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Task not found")
+}
+
+// listAgentTypes handles GET /api/v1/agent-swarm/agent-types
+func (s *Server) listAgentTypes(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"agent_types": agentTypes})
+}
+
+// findTask returns the orchestration task with id; callers must hold s.mu
+func (s *Server) findTask(id string) *ainative.Task {
+	for _, task := range s.tasks {
+		if task.ID == id {
+			return task
+		}
+	}
+	return nil
+}
+
+// createTask handles POST /api/v1/agent-orchestration/tasks
+func (s *Server) createTask(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.CreateTaskRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	switch {
+	case req.AgentID == "":
+		writeValidationError(w, "agent_id", "field required")
+		return
+	case req.TaskType == "":
+		writeValidationError(w, "task_type", "field required")
+		return
+	case req.Description == "":
+		writeValidationError(w, "description", "field required")
+		return
+	}
+	if req.Priority == "" {
+		req.Priority = string(ainative.TaskPriorityMedium)
+	}
+
+	created := now()
+	task := &ainative.Task{
+		ID:          s.newID("task"),
+		AgentID:     req.AgentID,
+		TaskType:    req.TaskType,
+		Description: req.Description,
+		Status:      string(ainative.TaskStatusPending),
+		Priority:    req.Priority,
+		Context:     req.Context,
+		CreatedAt:   created,
+		UpdatedAt:   created,
+	}
+	s.tasks = append(s.tasks, task)
+	s.workload(req.AgentID).QueuedTasks++
+
+	writeJSON(w, http.StatusCreated, ainative.CreateTaskResponse{ID: task.ID, Status: task.Status, Task: *task})
+}
+
+// listTasks handles GET /api/v1/agent-orchestration/tasks
+func (s *Server) listTasks(w http.ResponseWriter, r *http.Request, _ []string) {
+	agentID := r.URL.Query().Get("agent_id")
+	status := r.URL.Query().Get("status")
+
+	var tasks []ainative.Task
+	for _, task := range s.tasks {
+		if (agentID == "" || task.AgentID == agentID) && (status == "" || task.Status == status) {
+			tasks = append(tasks, *task)
+		}
+	}
+
+	limit, offset := pageBounds(r)
+	writeJSON(w, http.StatusOK, ainative.ListTasksResponse{
+		Tasks: paginate(tasks, limit, offset),
+		Total: len(tasks),
+	})
+}
+
+// taskStatus handles GET /api/v1/agent-orchestration/tasks/{id}/status
+func (s *Server) taskStatus(w http.ResponseWriter, r *http.Request, params []string) {
+	task := s.findTask(params[0])
+	if task == nil {
+		writeError(w, http.StatusNotFound, "Task not found")
+		return
+	}
+
+	progress := 0
+	if task.Status == string(ainative.TaskStatusCompleted) {
+		progress = 100
+	}
+
+	writeJSON(w, http.StatusOK, ainative.TaskStatusResponse{
+		ID:       task.ID,
+		Status:   task.Status,
+		Progress: &progress,
+		Result:   task.Result,
+	})
+}
+
+// executeTask handles POST /api/v1/agent-orchestration/tasks/{id}/execute.
+// Execution completes immediately; the result echoes the parameters.
+func (s *Server) executeTask(w http.ResponseWriter, r *http.Request, params []string) {
+	task := s.findTask(params[0])
+	if task == nil {
+		writeError(w, http.StatusNotFound, "Task not found")
+		return
+	}
+
+	var req struct {
+		Params map[string]interface{} `json:"params"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if task.Status == string(ainative.TaskStatusPending) {
+		workload := s.workload(task.AgentID)
+		workload.QueuedTasks--
+		workload.CompletedTasks++
+	}
+
+	completed := now()
+	task.Status = string(ainative.TaskStatusCompleted)
+	task.Result = map[string]interface{}{"params": req.Params}
+	task.UpdatedAt = completed
+	task.CompletedAt = &completed
+
+	writeJSON(w, http.StatusOK, ainative.ExecuteTaskResponse{ID: task.ID, Status: task.Status, Result: task.Result})
+}
+
+// createSequence handles POST /api/v1/agent-orchestration/sequences
+func (s *Server) createSequence(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.CreateTaskSequenceRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	switch {
+	case req.Name == "":
+		writeValidationError(w, "name", "field required")
+		return
+	case len(req.Tasks) == 0:
+		writeValidationError(w, "tasks", "ensure this value has at least 1 item")
+		return
+	}
+	for _, id := range req.Tasks {
+		if s.findTask(id) == nil {
+			writeError(w, http.StatusNotFound, "Task not found: "+id)
+			return
+		}
+	}
+
+	sequence := &ainative.TaskSequence{
+		ID:        s.newID("seq"),
+		Name:      req.Name,
+		Tasks:     req.Tasks,
+		Status:    string(ainative.TaskStatusPending),
+		CreatedAt: now(),
+	}
+	s.sequences = append(s.sequences, sequence)
+
+	writeJSON(w, http.StatusCreated, ainative.CreateTaskSequenceResponse{ID: sequence.ID, Sequence: *sequence})
+}
+
+// workload returns the workload record for agentID; callers must hold s.mu
+func (s *Server) workload(agentID string) *ainative.AgentWorkload {
+	workload, ok := s.workloads[agentID]
+	if !ok {
+		workload = &ainative.AgentWorkload{AgentID: agentID, Availability: 1}
+		s.workloads[agentID] = workload
+	}
+	return workload
+}
+
+// sendMessage handles POST /api/v1/agent-coordination/messages
+func (s *Server) sendMessage(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.SendMessageRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	switch {
+	case req.FromAgent == "":
+		writeValidationError(w, "from_agent", "field required")
+		return
+	case req.ToAgent == "":
+		writeValidationError(w, "to_agent", "field required")
+		return
+	case req.Message == "":
+		writeValidationError(w, "message", "field required")
+		return
+	}
+
+	sent := now()
+	message := ainative.AgentMessage{
+		ID:          s.newID("msg"),
+		FromAgent:   req.FromAgent,
+		ToAgent:     req.ToAgent,
+		Message:     req.Message,
+		MessageType: req.MessageType,
+		Priority:    req.Priority,
+		Metadata:    req.Metadata,
+		CreatedAt:   sent,
+		DeliveredAt: &sent,
+	}
+	s.messages = append(s.messages, message)
+
+	writeJSON(w, http.StatusOK, ainative.SendMessageResponse{MessageID: message.ID, Status: "delivered", Timestamp: sent})
+}
+
+// distributeTasks handles POST /api/v1/agent-coordination/distribute. The
+// load_balanced strategy assigns each task to the agent with the fewest
+// active and queued tasks; other strategies assign round robin.
+func (s *Server) distributeTasks(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.DistributeTasksRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Tasks) == 0 {
+		writeValidationError(w, "tasks", "ensure this value has at least 1 item")
+		return
+	}
+
+	agents := req.Agents
+	if len(agents) == 0 {
+		for agentID := range s.workloads {
+			agents = append(agents, agentID)
+		}
+		sort.Strings(agents)
+	}
+	if len(agents) == 0 {
+		writeValidationError(w, "agents", "no agents available")
+		return
+	}
+	if req.Strategy == "" {
+		req.Strategy = "load_balanced"
+	}
+
+	assignments := make([]ainative.TaskAssignment, len(req.Tasks))
+	for i, taskID := range req.Tasks {
+		agentID := agents[i%len(agents)]
+		if req.Strategy == "load_balanced" {
+			agentID = s.leastLoaded(agents)
+		}
+		s.workload(agentID).QueuedTasks++
+		assignments[i] = ainative.TaskAssignment{TaskID: taskID, AgentID: agentID, Status: "assigned"}
+	}
+
+	writeJSON(w, http.StatusOK, ainative.DistributeTasksResponse{
+		Assignments: assignments,
+		Strategy:    req.Strategy,
+		Timestamp:   now(),
+	})
+}
+
+// leastLoaded returns the agent with the fewest active and queued tasks
+func (s *Server) leastLoaded(agents []string) string {
+	best := agents[0]
+	bestLoad := -1
+	for _, agentID := range agents {
+		workload := s.workload(agentID)
+		load := workload.ActiveTasks + workload.QueuedTasks
+		if bestLoad < 0 || load < bestLoad {
+			best, bestLoad = agentID, load
+		}
+	}
+	return best
+}
+
+// workloadStats handles GET /api/v1/agent-coordination/workload
+func (s *Server) workloadStats(w http.ResponseWriter, r *http.Request, _ []string) {
+	agentIDs := r.URL.Query()["agent_ids"]
+	if len(agentIDs) == 0 {
+		for agentID := range s.workloads {
+			agentIDs = append(agentIDs, agentID)
+		}
+		sort.Strings(agentIDs)
+	}
+
+	response := ainative.GetWorkloadStatsResponse{Timestamp: now()}
+	for _, agentID := range agentIDs {
+		workload := *s.workload(agentID)
+		response.Workloads = append(response.Workloads, workload)
+		response.TotalTasks += workload.ActiveTasks + workload.QueuedTasks + workload.CompletedTasks + workload.FailedTasks
+		response.AverageLoad += float64(workload.ActiveTasks + workload.QueuedTasks)
+	}
+	if len(response.Workloads) > 0 {
+		response.AverageLoad /= float64(len(response.Workloads))
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// submitFeedback handles POST /api/v1/agent-learning/feedback
+func (s *Server) submitFeedback(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.SubmitFeedbackRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	switch {
+	case req.AgentID == "":
+		writeValidationError(w, "agent_id", "field required")
+		return
+	case req.Rating < 0 || req.Rating > 5:
+		writeValidationError(w, "rating", "ensure this value is between 0 and 5")
+		return
+	}
+
+	created := now()
+	feedback := ainative.Feedback{
+		ID:            s.newID("fb"),
+		AgentID:       req.AgentID,
+		InteractionID: req.InteractionID,
+		Rating:        req.Rating,
+		Comments:      req.Comments,
+		Metadata:      req.Metadata,
+		CreatedAt:     created,
+	}
+	s.feedback = append(s.feedback, feedback)
+
+	writeJSON(w, http.StatusOK, ainative.SubmitFeedbackResponse{FeedbackID: feedback.ID, Status: "received", Timestamp: created})
+}
+
+// agentMetrics computes performance metrics for an agent from its feedback
+// and orchestration tasks
+func (s *Server) agentMetrics(agentID string) ainative.PerformanceMetrics {
+	metrics := ainative.PerformanceMetrics{AgentID: agentID, LastUpdated: now()}
+
+	var ratings float64
+	for _, feedback := range s.feedback {
+		if feedback.AgentID == agentID {
+			metrics.TotalInteractions++
+			ratings += feedback.Rating
+		}
+	}
+	if metrics.TotalInteractions > 0 {
+		metrics.AverageRating = ratings / float64(metrics.TotalInteractions)
+	}
+
+	var completion time.Duration
+	for _, task := range s.tasks {
+		if task.AgentID != agentID {
+			continue
+		}
+		switch task.Status {
+		case string(ainative.TaskStatusCompleted):
+			metrics.SuccessfulTasks++
+			if task.CompletedAt != nil {
+				completion += task.CompletedAt.Sub(task.CreatedAt)
+			}
+		case string(ainative.TaskStatusFailed):
+			metrics.FailedTasks++
+		}
+	}
+	if finished := metrics.SuccessfulTasks + metrics.FailedTasks; finished > 0 {
+		metrics.SuccessRate = float64(metrics.SuccessfulTasks) / float64(finished)
+	}
+	if metrics.SuccessfulTasks > 0 {
+		metrics.AverageCompletionTime = float64(completion.Milliseconds()) / float64(metrics.SuccessfulTasks)
+	}
+
+	return metrics
+}
+
+// performanceMetrics handles GET /api/v1/agent-learning/metrics
+func (s *Server) performanceMetrics(w http.ResponseWriter, r *http.Request, _ []string) {
+	agentID := r.URL.Query().Get("agent_id")
+	if agentID == "" {
+		writeValidationError(w, "agent_id", "field required")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ainative.GetPerformanceMetricsResponse{
+		Metrics:   s.agentMetrics(agentID),
+		Timestamp: now(),
+	})
+}
+
+// compareAgents handles POST /api/v1/agent-learning/compare
+func (s *Server) compareAgents(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.CompareAgentsRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Agents) < 2 {
+		writeValidationError(w, "agents", "ensure this value has at least 2 items")
+		return
+	}
+	if req.Metric == "" {
+		req.Metric = "success_rate"
+	}
+
+	comparisons := make([]ainative.AgentComparison, len(req.Agents))
+	for i, agentID := range req.Agents {
+		metrics := s.agentMetrics(agentID)
+		score := metrics.SuccessRate
+		switch req.Metric {
+		case "avg_rating":
+			score = metrics.AverageRating
+		case "response_time":
+			score = -metrics.AverageResponseTime
+		}
+		comparisons[i] = ainative.AgentComparison{AgentID: agentID, Metrics: metrics, Score: score}
+	}
+
+	sort.SliceStable(comparisons, func(i, j int) bool { return comparisons[i].Score > comparisons[j].Score })
+	for i := range comparisons {
+		comparisons[i].Ranking = i + 1
+	}
+
+	writeJSON(w, http.StatusOK, ainative.CompareAgentsResponse{
+		Comparisons: comparisons,
+		Metric:      req.Metric,
+		Timestamp:   now(),
+	})
+}
+
+// pushState stores a new version of an agent's state; callers must hold s.mu
+func (s *Server) pushState(agentID string, state map[string]interface{}) ainative.AgentState {
+	history := s.states[agentID]
+	next := ainative.AgentState{
+		AgentID:   agentID,
+		Version:   len(history) + 1,
+		State:     state,
+		UpdatedAt: now(),
+	}
+	s.states[agentID] = append(history, next)
+	return next
+}
+
+// getState handles GET /api/v1/agent-state/state
+func (s *Server) getState(w http.ResponseWriter, r *http.Request, _ []string) {
+	agentID := r.URL.Query().Get("agent_id")
+	history := s.states[agentID]
+	if len(history) == 0 {
+		writeError(w, http.StatusNotFound, "Agent state not found")
+		return
+	}
+
+	state := history[len(history)-1]
+	if version, _ := strconv.Atoi(r.URL.Query().Get("version")); version > 0 {
+		if version > len(history) {
+			writeError(w, http.StatusNotFound, "State version not found")
+			return
+		}
+		state = history[version-1]
+	}
+
+	writeJSON(w, http.StatusOK, ainative.GetStateResponse{
+		AgentID:   state.AgentID,
+		Version:   state.Version,
+		State:     state.State,
+		Timestamp: state.UpdatedAt,
+	})
+}
+
+// createCheckpoint handles POST /api/v1/agent-state/checkpoints. The
+// checkpoint data also becomes the agent's current state.
+func (s *Server) createCheckpoint(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.CreateCheckpointRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	switch {
+	case req.AgentID == "":
+		writeValidationError(w, "agent_id", "field required")
+		return
+	case req.Name == "":
+		writeValidationError(w, "name", "field required")
+		return
+	case req.Data == nil:
+		writeValidationError(w, "data", "field required")
+		return
+	}
+
+	state := s.pushState(req.AgentID, req.Data)
+	checkpoint := &ainative.Checkpoint{
+		ID:          s.newID("ckpt"),
+		AgentID:     req.AgentID,
+		Name:        req.Name,
+		Description: req.Description,
+		Data:        req.Data,
+		Version:     state.Version,
+		CreatedAt:   state.UpdatedAt,
+	}
+	s.checkpoints = append(s.checkpoints, checkpoint)
+
+	writeJSON(w, http.StatusCreated, ainative.CreateCheckpointResponse{
+		CheckpointID: checkpoint.ID,
+		Version:      checkpoint.Version,
+		Message:      "Checkpoint created",
+		Timestamp:    checkpoint.CreatedAt,
+	})
+}
+
+// listCheckpoints handles GET /api/v1/agent-state/checkpoints
+func (s *Server) listCheckpoints(w http.ResponseWriter, r *http.Request, _ []string) {
+	agentID := r.URL.Query().Get("agent_id")
+
+	var checkpoints []ainative.Checkpoint
+	for _, checkpoint := range s.checkpoints {
+		if agentID == "" || checkpoint.AgentID == agentID {
+			checkpoints = append(checkpoints, *checkpoint)
+		}
+	}
+
+	limit, offset := pageBounds(r)
+	writeJSON(w, http.StatusOK, ainative.ListCheckpointsResponse{
+		Checkpoints: paginate(checkpoints, limit, offset),
+		TotalCount:  len(checkpoints),
+		Limit:       limit,
+		Offset:      offset,
+	})
+}
+
+// restoreCheckpoint handles POST /api/v1/agent-state/restore
+func (s *Server) restoreCheckpoint(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.RestoreCheckpointRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	var checkpoint *ainative.Checkpoint
+	for _, c := range s.checkpoints {
+		if c.ID == req.CheckpointID {
+			checkpoint = c
+		}
+	}
+	if checkpoint == nil {
+		writeError(w, http.StatusNotFound, "Checkpoint not found")
+		return
+	}
+	if checkpoint.AgentID != req.AgentID {
+		writeValidationError(w, "agent_id", "checkpoint belongs to a different agent")
+		return
+	}
+
+	state := s.pushState(req.AgentID, checkpoint.Data)
+
+	writeJSON(w, http.StatusOK, ainative.RestoreCheckpointResponse{
+		AgentID:   state.AgentID,
+		Version:   state.Version,
+		State:     state.State,
+		Status:    "restored",
+		Timestamp: state.UpdatedAt,
+	})
+}
@@ -0,0 +1,244 @@
+package ainativetest
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/ainative/go-sdk/ainative"
+)
+
+const (
+	// EmbeddingModel is the only model served by the fake server
+	EmbeddingModel = "BAAI/bge-small-en-v1.5"
+
+	// EmbeddingDimensions is the length of the fake server's embeddings
+	EmbeddingDimensions = 384
+)
+
+// Embed returns the deterministic embedding the fake server uses for text.
+// Each lowercased word contributes a pseudo-random direction seeded by its
+// hash, so texts sharing words have a higher cosine similarity. The result
+// has unit length unless text has no words.
+func Embed(text string) []float64 {
+	embedding := make([]float64, EmbeddingDimensions)
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, word := range words {
+		h := fnv.New64a()
+		h.Write([]byte(word))
+		state := h.Sum64()
+		for i := range embedding {
+			// splitmix64 step
+			state += 0x9e3779b97f4a7c15
+			z := state
+			z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+			z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+			z ^= z >> 31
+			embedding[i] += float64(z)/float64(math.MaxUint64)*2 - 1
+		}
+	}
+
+	var norm float64
+	for _, v := range embedding {
+		norm += v * v
+	}
+	if norm > 0 {
+		norm = math.Sqrt(norm)
+		for i := range embedding {
+			embedding[i] /= norm
+		}
+	}
+	return embedding
+}
+
+// generateEmbeddings handles POST /api/v1/embeddings/generate
+func (s *Server) generateEmbeddings(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.GenerateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if !validTexts(w, req.Texts) || !validModel(w, req.Model) {
+		return
+	}
+
+	embeddings := make([][]float64, len(req.Texts))
+	for i, text := range req.Texts {
+		embeddings[i] = Embed(text)
+	}
+	s.embeddingCount += len(req.Texts)
+
+	writeJSON(w, http.StatusOK, ainative.GenerateResponse{
+		Embeddings: embeddings,
+		Model:      EmbeddingModel,
+		Dimensions: EmbeddingDimensions,
+		Count:      len(embeddings),
+	})
+}
+
+// embedAndStore handles POST /api/v1/embeddings/embed-and-store
+func (s *Server) embedAndStore(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.EmbedAndStoreRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if !validTexts(w, req.Texts) || !validModel(w, req.Model) {
+		return
+	}
+	if req.MetadataList != nil && len(req.MetadataList) != len(req.Texts) {
+		writeValidationError(w, "metadata_list", "metadata_list length must match texts length")
+		return
+	}
+
+	project := s.projectOr404(w, req.ProjectID)
+	if project == nil {
+		return
+	}
+
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	for i, text := range req.Texts {
+		var metadata map[string]interface{}
+		if req.MetadataList != nil {
+			metadata = req.MetadataList[i]
+		}
+		s.storeVector(project, namespace, &storedVector{
+			item: ainative.VectorItem{
+				ID:       s.newID("vec"),
+				Vector:   Embed(text),
+				Metadata: metadata,
+			},
+			document: text,
+		})
+	}
+	s.embeddingCount += len(req.Texts)
+
+	writeJSON(w, http.StatusOK, ainative.EmbedAndStoreResponse{
+		Success:             true,
+		VectorsStored:       len(req.Texts),
+		EmbeddingsGenerated: len(req.Texts),
+		Model:               EmbeddingModel,
+		Dimensions:          EmbeddingDimensions,
+		Namespace:           namespace,
+	})
+}
+
+// semanticSearch handles POST /api/v1/embeddings/semantic-search
+func (s *Server) semanticSearch(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.SemanticSearchRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Query == "" {
+		writeValidationError(w, "query", "field required")
+		return
+	}
+	if !validModel(w, req.Model) {
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	project := s.projectOr404(w, req.ProjectID)
+	if project == nil {
+		return
+	}
+
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	where, ok := parseFilter(w, "filter_metadata", req.FilterMetadata)
+	if !ok {
+		return
+	}
+
+	var results []ainative.SemanticSearchResult
+	for _, result := range s.rankVectors(project.ID, namespace, Embed(req.Query), where) {
+		if result.score < req.Threshold || len(results) == req.Limit {
+			break
+		}
+		results = append(results, ainative.SemanticSearchResult{
+			VectorID:   result.vector.item.ID,
+			Similarity: result.score,
+			Document:   result.vector.document,
+			Metadata:   result.vector.item.Metadata,
+			Namespace:  namespace,
+		})
+	}
+	s.embeddingCount++
+
+	writeJSON(w, http.StatusOK, ainative.SemanticSearchResponse{
+		Results:      results,
+		Query:        req.Query,
+		TotalResults: len(results),
+		Model:        EmbeddingModel,
+	})
+}
+
+// listModels handles GET /api/v1/embeddings/models
+func (s *Server) listModels(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, []ainative.EmbeddingModel{{
+		ID:          EmbeddingModel,
+		Dimensions:  EmbeddingDimensions,
+		Description: "Deterministic hash embeddings (ainativetest)",
+		Speed:       "instant",
+		Loaded:      true,
+	}})
+}
+
+// embeddingsHealth handles GET /api/v1/embeddings/health
+func (s *Server) embeddingsHealth(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, ainative.HealthCheckResponse{
+		Status: "healthy",
+		EmbeddingService: map[string]interface{}{
+			"model":        EmbeddingModel,
+			"model_loaded": true,
+			"dimensions":   EmbeddingDimensions,
+		},
+		URL: s.URL,
+	})
+}
+
+// embeddingsUsage handles GET /api/v1/embeddings/usage
+func (s *Server) embeddingsUsage(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, ainative.UsageResponse{
+		UserID:                   "ainativetest",
+		EmbeddingsGeneratedToday: s.embeddingCount,
+		EmbeddingsGeneratedMonth: s.embeddingCount,
+		Model:                    EmbeddingModel,
+		Service:                  "ainativetest",
+	})
+}
+
+// validTexts checks the texts of an embedding request
+func validTexts(w http.ResponseWriter, texts []string) bool {
+	switch {
+	case len(texts) == 0:
+		writeValidationError(w, "texts", "ensure this value has at least 1 item")
+		return false
+	case len(texts) > 100:
+		writeValidationError(w, "texts", "ensure this value has at most 100 items")
+		return false
+	}
+	return true
+}
+
+// validModel checks that model is empty or the served model
+func validModel(w http.ResponseWriter, model string) bool {
+	if model != "" && model != EmbeddingModel {
+		writeValidationError(w, "model", fmt.Sprintf("unsupported model %q", model))
+		return false
+	}
+	return true
+}
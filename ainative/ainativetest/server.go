@@ -0,0 +1,382 @@
+// Package ainativetest provides an in-process fake of the AINative API for
+// tests.
+//
+// The fake server is stateful: projects, vectors, memories, swarms, tasks,
+// messages, feedback and agent state live in memory for the lifetime of the
+// server, so tests can exercise real read-after-write behavior without
+// network access or API keys. Vector and semantic search use cosine
+// similarity, and embeddings are deterministic hashes of the input text.
+//
+// Example:
+//
+//	srv := ainativetest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := srv.NewClient(nil)
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	project, err := client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "test"})
+package ainativetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ainative/go-sdk/ainative"
+)
+
+// APIKey is the API key used by clients created with Server.NewClient. The
+// server accepts any bearer token.
+const APIKey = "ainativetest-key"
+
+// Server is a stateful fake AINative API server
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	nextID   int
+	secret   string
+	verifier *ainative.RequestVerifier
+
+	projects       []*ainative.Project
+	vectors        map[string]map[string][]*storedVector
+	memories       []*ainative.MemoryItem
+	embeddingCount int
+
+	swarms     []*ainative.AgentSwarm
+	swarmTasks []*ainative.OrchestrationTask
+
+	tasks       []*ainative.Task
+	sequences   []*ainative.TaskSequence
+	messages    []ainative.AgentMessage
+	workloads   map[string]*ainative.AgentWorkload
+	feedback    []ainative.Feedback
+	states      map[string][]ainative.AgentState
+	checkpoints []*ainative.Checkpoint
+}
+
+// NewServer starts a fake AINative server. The caller should call Close when
+// finished to shut it down.
+func NewServer() *Server {
+	s := &Server{}
+	s.reset()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient creates a client for the server. BaseURL is always set to the
+// server URL; APIKey defaults to APIKey, and retries are disabled unless
+// configured. After RequireSignatures, SignRequests is set and APISecret
+// defaults to the server's secret. A nil config
+// uses these defaults.
+func (s *Server) NewClient(config *ainative.Config) (*ainative.Client, error) {
+	if config == nil {
+		config = &ainative.Config{}
+	}
+	config.BaseURL = s.URL
+	if config.APIKey == "" && config.TokenSource == nil {
+		config.APIKey = APIKey
+	}
+	s.mu.Lock()
+	if s.secret != "" {
+		config.SignRequests = true
+		if config.APISecret == "" {
+			config.APISecret = s.secret
+		}
+	}
+	s.mu.Unlock()
+	if config.RetryConfig == nil {
+		config.RetryConfig = &ainative.RetryConfig{MaxRetries: 0}
+	}
+	return ainative.NewClient(config)
+}
+
+// RequireSignatures makes the server reject requests that are not signed with
+// secret (see ainative.SignRequest) or that replay an earlier request
+func (s *Server) RequireSignatures(secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secret = secret
+	s.verifier = ainative.NewRequestVerifier(func(*http.Request) (string, error) {
+		return secret, nil
+	})
+}
+
+// Reset discards all stored data
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset()
+}
+
+// reset initializes the stores; callers must hold s.mu or own s exclusively
+func (s *Server) reset() {
+	s.nextID = 0
+	s.projects = nil
+	s.vectors = make(map[string]map[string][]*storedVector)
+	s.memories = nil
+	s.embeddingCount = 0
+	s.swarms = nil
+	s.swarmTasks = nil
+	s.tasks = nil
+	s.sequences = nil
+	s.messages = nil
+	s.workloads = make(map[string]*ainative.AgentWorkload)
+	s.feedback = nil
+	s.states = make(map[string][]ainative.AgentState)
+	s.checkpoints = nil
+}
+
+// Messages returns every agent message sent to the server
+func (s *Server) Messages() []ainative.AgentMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ainative.AgentMessage(nil), s.messages...)
+}
+
+// Feedback returns every feedback entry submitted to the server
+func (s *Server) Feedback() []ainative.Feedback {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ainative.Feedback(nil), s.feedback...)
+}
+
+// SetAgentState stores a new version of an agent's state, as if the agent had
+// saved it
+func (s *Server) SetAgentState(agentID string, state map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pushState(agentID, state)
+}
+
+// route maps a method and path pattern to a handler. A "*" segment in the
+// pattern matches any single path segment and is passed to the handler.
+type route struct {
+	method  string
+	pattern string
+	handle  func(s *Server, w http.ResponseWriter, r *http.Request, params []string)
+}
+
+var routes = []route{
+	{"GET", "/health", (*Server).health},
+
+	{"POST", "/api/v1/zerodb/projects", (*Server).createProject},
+	{"GET", "/api/v1/zerodb/projects", (*Server).listProjects},
+	{"GET", "/api/v1/zerodb/projects/*", (*Server).getProject},
+	{"PUT", "/api/v1/zerodb/projects/*", (*Server).updateProject},
+	{"DELETE", "/api/v1/zerodb/projects/*", (*Server).deleteProject},
+	{"POST", "/api/v1/zerodb/projects/*/suspend", (*Server).suspendProject},
+	{"POST", "/api/v1/zerodb/projects/*/activate", (*Server).activateProject},
+	{"POST", "/api/v1/zerodb/projects/*/vectors", (*Server).upsertVectors},
+	{"POST", "/api/v1/zerodb/projects/*/vectors/search", (*Server).searchVectors},
+	{"POST", "/api/v1/zerodb/projects/*/vectors/fetch", (*Server).fetchVectors},
+	{"POST", "/api/v1/zerodb/projects/*/vectors/delete", (*Server).deleteVectors},
+	{"GET", "/api/v1/zerodb/projects/*/vectors/ids", (*Server).listVectorIDs},
+	{"GET", "/api/v1/zerodb/projects/*/vectors/stats", (*Server).vectorStats},
+	{"PATCH", "/api/v1/zerodb/projects/*/vectors/*/metadata", (*Server).updateVectorMetadata},
+	{"GET", "/api/v1/zerodb/projects/*/namespaces", (*Server).listNamespaces},
+	{"GET", "/api/v1/zerodb/projects/*/namespaces/*", (*Server).describeNamespace},
+	{"DELETE", "/api/v1/zerodb/projects/*/namespaces/*", (*Server).deleteNamespace},
+	{"POST", "/api/v1/zerodb/projects/*/namespaces/*/copy", (*Server).copyNamespace},
+	{"POST", "/api/v1/zerodb/projects/*/namespaces/*/rename", (*Server).renameNamespace},
+
+	{"POST", "/api/v1/memory", (*Server).createMemory},
+	{"POST", "/api/v1/memory/search", (*Server).searchMemory},
+
+	{"POST", "/api/v1/embeddings/generate", (*Server).generateEmbeddings},
+	{"POST", "/api/v1/embeddings/embed-and-store", (*Server).embedAndStore},
+	{"POST", "/api/v1/embeddings/semantic-search", (*Server).semanticSearch},
+	{"GET", "/api/v1/embeddings/models", (*Server).listModels},
+	{"GET", "/api/v1/embeddings/health", (*Server).embeddingsHealth},
+	{"GET", "/api/v1/embeddings/usage", (*Server).embeddingsUsage},
+
+	{"POST", "/api/v1/agent-swarm/swarms", (*Server).startSwarm},
+	{"GET", "/api/v1/agent-swarm/swarms", (*Server).listSwarms},
+	{"GET", "/api/v1/agent-swarm/swarms/*", (*Server).getSwarm},
+	{"POST", "/api/v1/agent-swarm/swarms/*/stop", (*Server).stopSwarm},
+	{"POST", "/api/v1/agent-swarm/swarms/*/pause", (*Server).pauseSwarm},
+	{"POST", "/api/v1/agent-swarm/swarms/*/resume", (*Server).resumeSwarm},
+	{"GET", "/api/v1/agent-swarm/swarms/*/metrics", (*Server).swarmMetrics},
+	{"POST", "/api/v1/agent-swarm/orchestrate", (*Server).orchestrate},
+	{"GET", "/api/v1/agent-swarm/tasks/*", (*Server).getSwarmTask},
+	{"GET", "/api/v1/agent-swarm/agent-types", (*Server).listAgentTypes},
+
+	{"POST", "/api/v1/agent-orchestration/tasks", (*Server).createTask},
+	{"GET", "/api/v1/agent-orchestration/tasks", (*Server).listTasks},
+	{"GET", "/api/v1/agent-orchestration/tasks/*/status", (*Server).taskStatus},
+	{"POST", "/api/v1/agent-orchestration/tasks/*/execute", (*Server).executeTask},
+	{"POST", "/api/v1/agent-orchestration/sequences", (*Server).createSequence},
+
+	{"POST", "/api/v1/agent-coordination/messages", (*Server).sendMessage},
+	{"POST", "/api/v1/agent-coordination/distribute", (*Server).distributeTasks},
+	{"GET", "/api/v1/agent-coordination/workload", (*Server).workloadStats},
+
+	{"POST", "/api/v1/agent-learning/feedback", (*Server).submitFeedback},
+	{"GET", "/api/v1/agent-learning/metrics", (*Server).performanceMetrics},
+	{"POST", "/api/v1/agent-learning/compare", (*Server).compareAgents},
+
+	{"GET", "/api/v1/agent-state/state", (*Server).getState},
+	{"POST", "/api/v1/agent-state/checkpoints", (*Server).createCheckpoint},
+	{"GET", "/api/v1/agent-state/checkpoints", (*Server).listCheckpoints},
+	{"POST", "/api/v1/agent-state/restore", (*Server).restoreCheckpoint},
+}
+
+// serveHTTP authenticates and routes a request
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "Not authenticated")
+		return
+	}
+
+	s.mu.Lock()
+	verifier := s.verifier
+	s.mu.Unlock()
+	if verifier != nil {
+		if err := verifier.Verify(r); err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+	}
+
+	segments := splitPath(r.URL.EscapedPath())
+
+	pathMatched := false
+	for _, rt := range routes {
+		params, ok := matchPath(splitPath(rt.pattern), segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.method != r.Method {
+			continue
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		rt.handle(s, w, r, params)
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+// splitPath splits an escaped path into unescaped segments
+func splitPath(path string) []string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	for i, part := range parts {
+		if unescaped, err := url.PathUnescape(part); err == nil {
+			parts[i] = unescaped
+		}
+	}
+	return parts
+}
+
+// matchPath matches segments against pattern, returning the wildcard values
+func matchPath(pattern, segments []string) ([]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	var params []string
+	for i, part := range pattern {
+		if part == "*" {
+			params = append(params, segments[i])
+			continue
+		}
+		if part != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// newID returns a unique identifier with prefix; callers must hold s.mu
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s_%06d", prefix, s.nextID)
+}
+
+// now returns the current time as reported by the fake server
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// decode reads a JSON request body into v, writing a 400 response on failure
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// writeJSON writes v as a JSON response
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+// writeError writes a FastAPI-style {"detail": "..."} error
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, map[string]interface{}{"detail": detail})
+}
+
+// writeValidationError writes a FastAPI-style 422 for a single body field
+func writeValidationError(w http.ResponseWriter, field, msg string) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+		"detail": []map[string]interface{}{
+			{"loc": []string{"body", field}, "msg": msg, "type": "value_error"},
+		},
+	})
+}
+
+// pageBounds returns the limit and offset query parameters, defaulting the
+// limit to 10
+func pageBounds(r *http.Request) (limit, offset int) {
+	limit, _ = strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 {
+		limit = 10
+	}
+	offset, _ = strconv.Atoi(r.URL.Query().Get("offset"))
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// paginate returns the page of items selected by limit and offset
+func paginate[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
+
+// health handles GET /health
+func (s *Server) health(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, ainative.HealthResponse{
+		Status:    "healthy",
+		Version:   "ainativetest",
+		Timestamp: now(),
+		Services:  map[string]string{"zerodb": "healthy", "agent-swarm": "healthy", "embeddings": "healthy"},
+	})
+}
@@ -0,0 +1,487 @@
+package ainativetest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ainative/go-sdk/ainative"
+	"github.com/ainative/go-sdk/ainative/filter"
+)
+
+func newTestClient(t *testing.T) (*Server, *ainative.Client) {
+	t.Helper()
+
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	client, err := srv.NewClient(nil)
+	require.NoError(t, err)
+	return srv, client
+}
+
+func TestServer_Projects(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	project, err := client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "alpha"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, project.ID)
+	assert.Equal(t, ainative.ProjectStatusActive, project.Status)
+
+	_, err = client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "beta"})
+	require.NoError(t, err)
+
+	require.NoError(t, client.ZeroDB.Projects.Suspend(ctx, project.ID, "testing"))
+
+	list, err := client.ZeroDB.Projects.List(ctx, &ainative.ListProjectsRequest{Status: ainative.ProjectStatusSuspended})
+	require.NoError(t, err)
+	require.Len(t, list.Projects, 1)
+	assert.Equal(t, "alpha", list.Projects[0].Name)
+
+	updated, err := client.ZeroDB.Projects.Update(ctx, project.ID, &ainative.UpdateProjectRequest{Description: "updated"})
+	require.NoError(t, err)
+	assert.Equal(t, "updated", updated.Description)
+	assert.Equal(t, ainative.ProjectStatusSuspended, updated.Status)
+
+	all, err := client.ZeroDB.Projects.ListAll(ctx, &ainative.ListProjectsRequest{Limit: 1}).Collect()
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	require.NoError(t, client.ZeroDB.Projects.Delete(ctx, project.ID))
+	_, err = client.ZeroDB.Projects.Get(ctx, project.ID)
+	assert.True(t, errors.Is(err, ainative.ErrNotFound))
+}
+
+func TestServer_VectorSearch(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	project, err := client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "vectors"})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Vectors.Upsert(ctx, project.ID, &ainative.UpsertVectorsRequest{
+		Vectors: []ainative.VectorItem{
+			{ID: "x", Vector: []float64{1, 0, 0}, Metadata: map[string]interface{}{"axis": "x"}},
+			{ID: "y", Vector: []float64{0, 1, 0}, Metadata: map[string]interface{}{"axis": "y"}},
+			{ID: "xy", Vector: []float64{1, 1, 0}, Metadata: map[string]interface{}{"axis": "xy"}},
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := client.ZeroDB.Vectors.Search(ctx, project.ID, &ainative.VectorSearchRequest{
+		Vector:          []float64{1, 0.1, 0},
+		TopK:            2,
+		IncludeMetadata: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Matches, 2)
+	assert.Equal(t, "x", result.Matches[0].ID)
+	assert.Equal(t, "xy", result.Matches[1].ID)
+	assert.Equal(t, "x", result.Matches[0].Metadata["axis"])
+	assert.Greater(t, result.Matches[0].Score, result.Matches[1].Score)
+
+	filtered, err := client.ZeroDB.Vectors.Search(ctx, project.ID, &ainative.VectorSearchRequest{
+		Vector: []float64{1, 0, 0},
+		Filter: map[string]interface{}{"axis": "y"},
+	})
+	require.NoError(t, err)
+	require.Len(t, filtered.Matches, 1)
+	assert.Equal(t, "y", filtered.Matches[0].ID)
+
+	filtered, err = client.ZeroDB.Vectors.Search(ctx, project.ID, &ainative.VectorSearchRequest{
+		Vector: []float64{1, 0, 0},
+		Filter: filter.In("axis", "x", "xy").Map(),
+	})
+	require.NoError(t, err)
+	require.Len(t, filtered.Matches, 2)
+	assert.Equal(t, "x", filtered.Matches[0].ID)
+}
+
+func TestServer_CompactVectors(t *testing.T) {
+	srv, _ := newTestClient(t)
+	ctx := context.Background()
+
+	client, err := srv.NewClient(&ainative.Config{VectorEncoding: ainative.VectorEncodingBase64})
+	require.NoError(t, err)
+
+	project, err := client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "compact"})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Vectors.Upsert(ctx, project.ID, &ainative.UpsertVectorsRequest{
+		Vectors: []ainative.VectorItem{
+			{ID: "x", Vector32: []float32{1, 0}},
+			{ID: "y", Vector: []float64{0, 1}},
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := client.ZeroDB.Vectors.Search(ctx, project.ID, &ainative.VectorSearchRequest{
+		Vector32:      []float32{0.5, 1},
+		TopK:          1,
+		IncludeValues: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Matches, 1)
+	assert.Equal(t, "y", result.Matches[0].ID)
+	assert.Equal(t, []float32{0, 1}, result.Matches[0].Float32())
+
+	fetched, err := client.ZeroDB.Vectors.Fetch(ctx, project.ID, &ainative.FetchVectorsRequest{IDs: []string{"x"}})
+	require.NoError(t, err)
+	require.Len(t, fetched.Vectors, 1)
+	assert.Equal(t, []float64{1, 0}, fetched.Vectors[0].Float64())
+}
+
+func TestServer_VectorCRUD(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	project, err := client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "crud"})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Vectors.Upsert(ctx, project.ID, &ainative.UpsertVectorsRequest{
+		Vectors: []ainative.VectorItem{
+			{ID: "doc_2", Vector: []float64{0, 1}, Metadata: map[string]interface{}{"source": "wiki"}},
+			{ID: "doc_1", Vector: []float64{1, 0}, Metadata: map[string]interface{}{"source": "wiki"}},
+			{ID: "img_1", Vector: []float64{1, 1}, Metadata: map[string]interface{}{"source": "upload"}},
+		},
+	})
+	require.NoError(t, err)
+
+	fetched, err := client.ZeroDB.Vectors.Fetch(ctx, project.ID, &ainative.FetchVectorsRequest{IDs: []string{"doc_1", "missing"}})
+	require.NoError(t, err)
+	require.Len(t, fetched.Vectors, 1)
+	assert.Equal(t, []float64{1, 0}, fetched.Vectors[0].Vector)
+
+	updated, err := client.ZeroDB.Vectors.UpdateMetadata(ctx, project.ID, "doc_1", &ainative.UpdateVectorMetadataRequest{
+		Metadata: map[string]interface{}{"version": 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"source": "wiki", "version": float64(2)}, updated.Metadata)
+
+	ids, err := client.ZeroDB.Vectors.ListAllIDs(ctx, project.ID, &ainative.ListVectorIDsRequest{Prefix: "doc_", Limit: 1}).Collect()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc_1", "doc_2"}, ids)
+
+	stats, err := client.ZeroDB.Vectors.GetStats(ctx, project.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.TotalVectorCount)
+	assert.Equal(t, 2, stats.Dimension)
+
+	deleted, err := client.ZeroDB.Vectors.Delete(ctx, project.ID, &ainative.DeleteVectorsRequest{
+		Filter: map[string]interface{}{"source": "wiki"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted.DeletedCount)
+
+	deleted, err = client.ZeroDB.Vectors.Delete(ctx, project.ID, &ainative.DeleteVectorsRequest{IDs: []string{"img_1"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted.DeletedCount)
+
+	stats, err = client.ZeroDB.Vectors.GetStats(ctx, project.ID, "")
+	require.NoError(t, err)
+	assert.Zero(t, stats.TotalVectorCount)
+
+	_, err = client.ZeroDB.Vectors.UpdateMetadata(ctx, project.ID, "doc_1", &ainative.UpdateVectorMetadataRequest{Replace: true})
+	assert.True(t, errors.Is(err, ainative.ErrNotFound))
+}
+
+func TestServer_Namespaces(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	project, err := client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "tenants"})
+	require.NoError(t, err)
+
+	for namespace, count := range map[string]int{"tenant-a": 2, "tenant-b": 1} {
+		vectors := make([]ainative.VectorItem, count)
+		for i := range vectors {
+			vectors[i] = ainative.VectorItem{ID: string(rune('a' + i)), Vector: []float64{1, 2, 3}}
+		}
+		_, err = client.ZeroDB.Vectors.Upsert(ctx, project.ID, &ainative.UpsertVectorsRequest{Vectors: vectors, Namespace: namespace})
+		require.NoError(t, err)
+	}
+
+	list, err := client.ZeroDB.Namespaces.List(ctx, project.ID, &ainative.ListNamespacesRequest{Prefix: "tenant-"})
+	require.NoError(t, err)
+	assert.Equal(t, []ainative.Namespace{
+		{Name: "tenant-a", VectorCount: 2, Dimension: 3},
+		{Name: "tenant-b", VectorCount: 1, Dimension: 3},
+	}, list.Namespaces)
+
+	_, err = client.ZeroDB.Namespaces.Rename(ctx, project.ID, "tenant-a", &ainative.RenameNamespaceRequest{Name: "tenant-b"})
+	assert.True(t, errors.Is(err, ainative.ErrConflict))
+
+	copied, err := client.ZeroDB.Namespaces.Copy(ctx, project.ID, "tenant-a", &ainative.CopyNamespaceRequest{Destination: "backup"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), copied.VectorCount)
+
+	renamed, err := client.ZeroDB.Namespaces.Rename(ctx, project.ID, "tenant-a", &ainative.RenameNamespaceRequest{Name: "tenant-c"})
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-c", renamed.Name)
+	_, err = client.ZeroDB.Namespaces.Describe(ctx, project.ID, "tenant-a")
+	assert.True(t, errors.Is(err, ainative.ErrNotFound))
+
+	deleted, err := client.ZeroDB.Namespaces.Delete(ctx, project.ID, "tenant-c")
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted.DeletedCount)
+
+	stats, err := client.ZeroDB.Vectors.GetStats(ctx, project.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.TotalVectorCount)
+	assert.Len(t, stats.Namespaces, 2)
+
+	fetched, err := client.ZeroDB.Projects.Get(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), fetched.Stats.VectorCount)
+}
+
+func TestServer_Embeddings(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	generated, err := client.ZeroDB.Embeddings.Generate(ctx, []string{"hello world", "hello world"}, "", true)
+	require.NoError(t, err)
+	require.Len(t, generated.Embeddings, 2)
+	assert.Len(t, generated.Embeddings[0], EmbeddingDimensions)
+	assert.Equal(t, generated.Embeddings[0], generated.Embeddings[1])
+	assert.InDelta(t, 1.0, cosine(generated.Embeddings[0], Embed("Hello, world!")), 1e-9)
+
+	project, err := client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "docs"})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Embeddings.EmbedAndStore(ctx, project.ID,
+		[]string{"training neural networks", "baking sourdough bread", "neural network architectures"},
+		[]map[string]interface{}{{"topic": "ml"}, {"topic": "food"}, {"topic": "ml"}},
+		"", "")
+	require.NoError(t, err)
+
+	results, err := client.ZeroDB.Embeddings.SemanticSearch(ctx, project.ID, "neural networks", 10, 0.2, "", nil, "")
+	require.NoError(t, err)
+	require.Len(t, results.Results, 2)
+	assert.Equal(t, "training neural networks", results.Results[0].Document)
+	for _, result := range results.Results {
+		assert.Equal(t, "ml", result.Metadata["topic"])
+	}
+
+	usage, err := client.ZeroDB.Embeddings.GetUsage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 6, usage.EmbeddingsGeneratedToday)
+}
+
+func TestServer_Memory(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.ZeroDB.Memory.Create(ctx, &ainative.CreateMemoryRequest{Content: "The deploy key rotates monthly", Tags: []string{"ops"}})
+	require.NoError(t, err)
+	_, err = client.ZeroDB.Memory.Create(ctx, &ainative.CreateMemoryRequest{Content: "Lunch is at noon", Tags: []string{"office"}})
+	require.NoError(t, err)
+
+	result, err := client.ZeroDB.Memory.Search(ctx, &ainative.SearchMemoryRequest{Query: "deploy"})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, ainative.MemoryPriorityMedium, result.Results[0].Priority)
+
+	semantic, err := client.ZeroDB.Memory.Search(ctx, &ainative.SearchMemoryRequest{Query: "when is lunch", Semantic: true, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, semantic.Results, 1)
+	assert.Equal(t, "Lunch is at noon", semantic.Results[0].Content)
+}
+
+func TestServer_SwarmsAndTasks(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	swarm, err := client.AgentSwarm.Start(ctx, &ainative.StartSwarmRequest{
+		ProjectID: "proj_1",
+		Objective: "review code",
+		Agents:    []ainative.AgentConfig{{Type: ainative.AgentTypeCodeReviewer, Count: 2}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, swarm.Agents, 2)
+	assert.Equal(t, ainative.SwarmStatusRunning, swarm.Status)
+
+	require.NoError(t, client.AgentSwarm.Pause(ctx, swarm.ID))
+	_, err = client.AgentSwarm.Orchestrate(ctx, &ainative.OrchestrationRequest{SwarmID: swarm.ID, Task: "review PR"})
+	var apiErr *ainative.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+
+	require.NoError(t, client.AgentSwarm.Resume(ctx, swarm.ID))
+	orchestration, err := client.AgentSwarm.Orchestrate(ctx, &ainative.OrchestrationRequest{SwarmID: swarm.ID, Task: "review PR"})
+	require.NoError(t, err)
+	assert.Len(t, orchestration.AssignedTo, 2)
+
+	task, err := client.AgentSwarm.GetTask(ctx, orchestration.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, "review PR", task.Description)
+
+	created, err := client.AgentOrchestration.CreateTask(ctx, &ainative.CreateTaskRequest{
+		AgentID: "agent_1", TaskType: "analysis", Description: "analyze logs",
+	})
+	require.NoError(t, err)
+
+	executed, err := client.AgentOrchestration.ExecuteTask(ctx, &ainative.ExecuteTaskRequest{
+		TaskID: created.ID, Params: map[string]interface{}{"depth": "full"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "completed", executed.Status)
+
+	status, err := client.AgentOrchestration.GetTaskStatus(ctx, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, status.Progress)
+	assert.Equal(t, 100, *status.Progress)
+
+	tasks, err := client.AgentOrchestration.ListTasks(ctx, &ainative.ListTasksRequest{Status: "completed"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, tasks.Total)
+}
+
+func TestServer_CoordinationAndLearning(t *testing.T) {
+	srv, client := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.AgentCoordination.SendMessage(ctx, &ainative.SendMessageRequest{
+		FromAgent: "agent_1", ToAgent: "agent_2", Message: "ready",
+	})
+	require.NoError(t, err)
+	require.Len(t, srv.Messages(), 1)
+	assert.Equal(t, "ready", srv.Messages()[0].Message)
+
+	distributed, err := client.AgentCoordination.DistributeTasks(ctx, &ainative.DistributeTasksRequest{
+		Tasks:  []string{"t1", "t2", "t3", "t4"},
+		Agents: []string{"agent_1", "agent_2"},
+	})
+	require.NoError(t, err)
+	counts := map[string]int{}
+	for _, assignment := range distributed.Assignments {
+		counts[assignment.AgentID]++
+	}
+	assert.Equal(t, map[string]int{"agent_1": 2, "agent_2": 2}, counts)
+
+	workload, err := client.AgentCoordination.GetWorkloadStats(ctx, &ainative.GetWorkloadStatsRequest{AgentIDs: []string{"agent_1"}})
+	require.NoError(t, err)
+	require.Len(t, workload.Workloads, 1)
+	assert.Equal(t, 2, workload.Workloads[0].QueuedTasks)
+
+	for _, rating := range []float64{4, 5} {
+		_, err = client.AgentLearning.SubmitFeedback(ctx, &ainative.SubmitFeedbackRequest{AgentID: "agent_1", Rating: rating})
+		require.NoError(t, err)
+	}
+	_, err = client.AgentLearning.SubmitFeedback(ctx, &ainative.SubmitFeedbackRequest{AgentID: "agent_2", Rating: 2})
+	require.NoError(t, err)
+	assert.Len(t, srv.Feedback(), 3)
+
+	metrics, err := client.AgentLearning.GetPerformanceMetrics(ctx, &ainative.GetPerformanceMetricsRequest{AgentID: "agent_1"})
+	require.NoError(t, err)
+	assert.Equal(t, 4.5, metrics.Metrics.AverageRating)
+	assert.Equal(t, 2, metrics.Metrics.TotalInteractions)
+
+	comparison, err := client.AgentLearning.CompareAgents(ctx, &ainative.CompareAgentsRequest{
+		Agents: []string{"agent_2", "agent_1"}, Metric: "avg_rating",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "agent_1", comparison.Comparisons[0].AgentID)
+	assert.Equal(t, 1, comparison.Comparisons[0].Ranking)
+}
+
+func TestServer_StateAndCheckpoints(t *testing.T) {
+	srv, client := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.AgentState.GetState(ctx, &ainative.GetStateRequest{AgentID: "agent_1"})
+	assert.True(t, errors.Is(err, ainative.ErrNotFound))
+
+	srv.SetAgentState("agent_1", map[string]interface{}{"step": "one"})
+
+	checkpoint, err := client.AgentState.CreateCheckpoint(ctx, &ainative.CreateCheckpointRequest{
+		AgentID: "agent_1", Name: "before", Data: map[string]interface{}{"step": "two"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, checkpoint.Version)
+
+	srv.SetAgentState("agent_1", map[string]interface{}{"step": "three"})
+
+	restored, err := client.AgentState.RestoreCheckpoint(ctx, &ainative.RestoreCheckpointRequest{
+		CheckpointID: checkpoint.CheckpointID, AgentID: "agent_1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, restored.Version)
+	assert.Equal(t, "two", restored.State["step"])
+
+	first, err := client.AgentState.GetState(ctx, &ainative.GetStateRequest{AgentID: "agent_1", Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "one", first.State["step"])
+
+	checkpoints, err := client.AgentState.ListCheckpoints(ctx, &ainative.ListCheckpointsRequest{AgentID: "agent_1"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, checkpoints.TotalCount)
+}
+
+func TestServer_ErrorsAndAuth(t *testing.T) {
+	srv, client := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.ZeroDB.Vectors.Upsert(ctx, "missing", &ainative.UpsertVectorsRequest{
+		Vectors: []ainative.VectorItem{{ID: "v", Vector: []float64{1}}},
+	})
+	assert.True(t, errors.Is(err, ainative.ErrNotFound))
+
+	_, err = client.ZeroDB.Embeddings.Generate(ctx, []string{"text"}, "unknown/model", true)
+	var apiErr *ainative.APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Len(t, apiErr.ValidationErrors, 1)
+	assert.Equal(t, "model", apiErr.ValidationErrors[0].Field)
+
+	resp, err := http.Get(srv.URL + "/health")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	health, err := client.Health(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "healthy", health.Status)
+
+	srv.Reset()
+	list, err := client.ZeroDB.Projects.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, list.Projects)
+}
+
+func TestServer_RequireSignatures(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.RequireSignatures("server-secret")
+	ctx := context.Background()
+
+	client, err := srv.NewClient(nil)
+	require.NoError(t, err)
+	_, err = client.ZeroDB.Projects.Create(ctx, &ainative.CreateProjectRequest{Name: "signed"})
+	require.NoError(t, err)
+
+	unsigned, err := srv.NewClient(&ainative.Config{APISecret: "wrong-secret"})
+	require.NoError(t, err)
+	_, err = unsigned.ZeroDB.Projects.List(ctx, nil)
+	assert.True(t, errors.Is(err, ainative.ErrUnauthorized))
+
+	// A captured request cannot be sent again
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/health", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+APIKey)
+	require.NoError(t, ainative.SignRequest(req, "server-secret"))
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
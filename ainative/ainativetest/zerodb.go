@@ -0,0 +1,743 @@
+package ainativetest
+
+import (
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ainative/go-sdk/ainative"
+	"github.com/ainative/go-sdk/ainative/filter"
+)
+
+// defaultNamespace is used when a request does not name a namespace
+const defaultNamespace = "default"
+
+// storedVector is a vector held by the fake server
+type storedVector struct {
+	item     ainative.VectorItem
+	document string
+}
+
+// findProject returns the live project with id; callers must hold s.mu
+func (s *Server) findProject(id string) *ainative.Project {
+	for _, project := range s.projects {
+		if project.ID == id {
+			return project
+		}
+	}
+	return nil
+}
+
+// projectOr404 returns the project with id, writing a 404 if it is missing
+func (s *Server) projectOr404(w http.ResponseWriter, id string) *ainative.Project {
+	project := s.findProject(id)
+	if project == nil {
+		writeError(w, http.StatusNotFound, "Project not found")
+	}
+	return project
+}
+
+// createProject handles POST /api/v1/zerodb/projects
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.CreateProjectRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeValidationError(w, "name", "field required")
+		return
+	}
+
+	created := now()
+	project := &ainative.Project{
+		ID:          s.newID("proj"),
+		Name:        req.Name,
+		Description: req.Description,
+		Status:      ainative.ProjectStatusActive,
+		Metadata:    req.Metadata,
+		CreatedAt:   created,
+		UpdatedAt:   created,
+		Stats:       &ainative.ProjectStats{},
+	}
+	s.projects = append(s.projects, project)
+
+	writeJSON(w, http.StatusCreated, project)
+}
+
+// listProjects handles GET /api/v1/zerodb/projects
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request, _ []string) {
+	status := ainative.ProjectStatus(r.URL.Query().Get("status"))
+
+	var projects []ainative.Project
+	for _, project := range s.projects {
+		if status == "" || project.Status == status {
+			projects = append(projects, *project)
+		}
+	}
+
+	limit, offset := pageBounds(r)
+	writeJSON(w, http.StatusOK, ainative.ListProjectsResponse{
+		Projects:   paginate(projects, limit, offset),
+		TotalCount: len(projects),
+		Limit:      limit,
+		Offset:     offset,
+	})
+}
+
+// getProject handles GET /api/v1/zerodb/projects/{id}
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request, params []string) {
+	if project := s.projectOr404(w, params[0]); project != nil {
+		writeJSON(w, http.StatusOK, project)
+	}
+}
+
+// updateProject handles PUT /api/v1/zerodb/projects/{id}
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	var req ainative.UpdateProjectRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Name != "" {
+		project.Name = req.Name
+	}
+	if req.Description != "" {
+		project.Description = req.Description
+	}
+	if req.Metadata != nil {
+		project.Metadata = req.Metadata
+	}
+	project.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, project)
+}
+
+// deleteProject handles DELETE /api/v1/zerodb/projects/{id}
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request, params []string) {
+	for i, project := range s.projects {
+		if project.ID == params[0] {
+			s.projects = append(s.projects[:i], s.projects[i+1:]...)
+			delete(s.vectors, project.ID)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Project not found")
+}
+
+// suspendProject handles POST /api/v1/zerodb/projects/{id}/suspend
+func (s *Server) suspendProject(w http.ResponseWriter, r *http.Request, params []string) {
+	s.setProjectStatus(w, params[0], ainative.ProjectStatusSuspended)
+}
+
+// activateProject handles POST /api/v1/zerodb/projects/{id}/activate
+func (s *Server) activateProject(w http.ResponseWriter, r *http.Request, params []string) {
+	s.setProjectStatus(w, params[0], ainative.ProjectStatusActive)
+}
+
+// setProjectStatus changes a project's status
+func (s *Server) setProjectStatus(w http.ResponseWriter, id string, status ainative.ProjectStatus) {
+	project := s.projectOr404(w, id)
+	if project == nil {
+		return
+	}
+	project.Status = status
+	project.UpdatedAt = now()
+	writeJSON(w, http.StatusOK, project)
+}
+
+// upsertVectors handles POST /api/v1/zerodb/projects/{id}/vectors
+func (s *Server) upsertVectors(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	var req ainative.UpsertVectorsRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Vectors) == 0 {
+		writeValidationError(w, "vectors", "ensure this value has at least 1 item")
+		return
+	}
+	for _, item := range req.Vectors {
+		if item.ID == "" {
+			writeValidationError(w, "vectors.id", "field required")
+			return
+		}
+		if len(item.Vector) == 0 && len(item.Vector32) == 0 {
+			writeValidationError(w, "vectors.vector", "ensure this value has at least 1 item")
+			return
+		}
+	}
+
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	// Compact vectors are stored as float64 like any other
+	for _, item := range req.Vectors {
+		item.Vector, item.Vector32 = item.Float64(), nil
+		s.storeVector(project, namespace, &storedVector{item: item})
+	}
+
+	writeJSON(w, http.StatusOK, ainative.UpsertVectorsResponse{
+		UpsertedCount: len(req.Vectors),
+		Namespace:     namespace,
+	})
+}
+
+// storeVector inserts or replaces a vector; callers must hold s.mu
+func (s *Server) storeVector(project *ainative.Project, namespace string, vector *storedVector) {
+	namespaces := s.vectors[project.ID]
+	if namespaces == nil {
+		namespaces = make(map[string][]*storedVector)
+		s.vectors[project.ID] = namespaces
+	}
+
+	for i, existing := range namespaces[namespace] {
+		if existing.item.ID == vector.item.ID {
+			namespaces[namespace][i] = vector
+			return
+		}
+	}
+	namespaces[namespace] = append(namespaces[namespace], vector)
+
+	if project.Stats != nil {
+		project.Stats.VectorCount++
+	}
+}
+
+// scoredVector is a vector with its similarity to a query
+type scoredVector struct {
+	vector *storedVector
+	score  float64
+}
+
+// rankVectors scores the vectors of a namespace against query, keeping those
+// matching where, best first
+func (s *Server) rankVectors(projectID, namespace string, query []float64, where filter.Filter) []scoredVector {
+	var ranked []scoredVector
+	for _, vector := range s.vectors[projectID][namespace] {
+		if !where.Match(vector.item.Metadata) {
+			continue
+		}
+		ranked = append(ranked, scoredVector{vector: vector, score: cosine(query, vector.item.Vector)})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].vector.item.ID < ranked[j].vector.item.ID
+	})
+	return ranked
+}
+
+// searchVectors handles POST /api/v1/zerodb/projects/{id}/vectors/search
+func (s *Server) searchVectors(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	var req ainative.VectorSearchRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	query := req.Float64()
+	if len(query) == 0 {
+		writeValidationError(w, "vector", "ensure this value has at least 1 item")
+		return
+	}
+	if req.TopK <= 0 {
+		req.TopK = 5
+	}
+
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	where, ok := parseFilter(w, "filter", req.Filter)
+	if !ok {
+		return
+	}
+
+	ranked := s.rankVectors(project.ID, namespace, query, where)
+	if len(ranked) > req.TopK {
+		ranked = ranked[:req.TopK]
+	}
+
+	matches := make([]ainative.VectorSearchMatch, len(ranked))
+	for i, result := range ranked {
+		matches[i] = ainative.VectorSearchMatch{ID: result.vector.item.ID, Score: result.score}
+		if req.IncludeMetadata {
+			matches[i].Metadata = result.vector.item.Metadata
+		}
+		if req.IncludeValues {
+			matches[i].Vector = result.vector.item.Vector
+		}
+	}
+
+	writeJSON(w, http.StatusOK, ainative.VectorSearchResponse{Matches: matches, Namespace: namespace})
+}
+
+// namespaceOrDefault returns namespace, or defaultNamespace when it is empty
+func namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return defaultNamespace
+	}
+	return namespace
+}
+
+// fetchVectors handles POST /api/v1/zerodb/projects/{id}/vectors/fetch
+func (s *Server) fetchVectors(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	var req ainative.FetchVectorsRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.IDs) == 0 {
+		writeValidationError(w, "ids", "ensure this value has at least 1 item")
+		return
+	}
+
+	namespace := namespaceOrDefault(req.Namespace)
+	vectors := []ainative.VectorItem{}
+	for _, id := range req.IDs {
+		for _, vector := range s.vectors[project.ID][namespace] {
+			if vector.item.ID == id {
+				vectors = append(vectors, vector.item)
+				break
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, ainative.FetchVectorsResponse{Vectors: vectors, Namespace: namespace})
+}
+
+// deleteVectors handles POST /api/v1/zerodb/projects/{id}/vectors/delete
+func (s *Server) deleteVectors(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	var req ainative.DeleteVectorsRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if (len(req.IDs) == 0) == (len(req.Filter) == 0) {
+		writeValidationError(w, "ids", "exactly one of ids or filter is required")
+		return
+	}
+
+	where, ok := parseFilter(w, "filter", req.Filter)
+	if !ok {
+		return
+	}
+
+	ids := make(map[string]bool, len(req.IDs))
+	for _, id := range req.IDs {
+		ids[id] = true
+	}
+
+	namespace := namespaceOrDefault(req.Namespace)
+	var kept []*storedVector
+	deleted := 0
+	for _, vector := range s.vectors[project.ID][namespace] {
+		if ids[vector.item.ID] || (!where.IsZero() && where.Match(vector.item.Metadata)) {
+			deleted++
+			continue
+		}
+		kept = append(kept, vector)
+	}
+	if deleted > 0 {
+		s.setNamespace(project, namespace, kept)
+	}
+
+	writeJSON(w, http.StatusOK, ainative.DeleteVectorsResponse{DeletedCount: deleted, Namespace: namespace})
+}
+
+// updateVectorMetadata handles PATCH
+// /api/v1/zerodb/projects/{id}/vectors/{id}/metadata
+func (s *Server) updateVectorMetadata(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	var req ainative.UpdateVectorMetadataRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	for _, vector := range s.vectors[project.ID][namespaceOrDefault(req.Namespace)] {
+		if vector.item.ID != params[1] {
+			continue
+		}
+
+		metadata := make(map[string]interface{}, len(vector.item.Metadata)+len(req.Metadata))
+		if !req.Replace {
+			for key, value := range vector.item.Metadata {
+				metadata[key] = value
+			}
+		}
+		for key, value := range req.Metadata {
+			metadata[key] = value
+		}
+		vector.item.Metadata = metadata
+
+		writeJSON(w, http.StatusOK, vector.item)
+		return
+	}
+	writeError(w, http.StatusNotFound, "Vector not found")
+}
+
+// listVectorIDs handles GET /api/v1/zerodb/projects/{id}/vectors/ids
+func (s *Server) listVectorIDs(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	namespace := namespaceOrDefault(r.URL.Query().Get("namespace"))
+	prefix := r.URL.Query().Get("prefix")
+
+	var ids []string
+	for _, vector := range s.vectors[project.ID][namespace] {
+		if strings.HasPrefix(vector.item.ID, prefix) {
+			ids = append(ids, vector.item.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	limit, offset := pageBounds(r)
+	writeJSON(w, http.StatusOK, ainative.ListVectorIDsResponse{
+		IDs:        paginate(ids, limit, offset),
+		Namespace:  namespace,
+		TotalCount: len(ids),
+		Limit:      limit,
+		Offset:     offset,
+	})
+}
+
+// vectorStats handles GET /api/v1/zerodb/projects/{id}/vectors/stats
+func (s *Server) vectorStats(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	only := r.URL.Query().Get("namespace")
+	namespaces := make([]string, 0, len(s.vectors[project.ID]))
+	for namespace := range s.vectors[project.ID] {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	stats := ainative.VectorStats{Namespaces: map[string]ainative.NamespaceStats{}}
+	for _, namespace := range namespaces {
+		vectors := s.vectors[project.ID][namespace]
+		if (only != "" && namespace != only) || len(vectors) == 0 {
+			continue
+		}
+
+		dimension := len(vectors[0].item.Vector)
+		stats.Namespaces[namespace] = ainative.NamespaceStats{VectorCount: int64(len(vectors)), Dimension: dimension}
+		stats.TotalVectorCount += int64(len(vectors))
+		if stats.Dimension == 0 {
+			stats.Dimension = dimension
+		}
+	}
+
+	writeJSON(w, http.StatusOK, stats)
+}
+
+// namespaceInfo describes the vectors of a namespace
+func namespaceInfo(name string, vectors []*storedVector) ainative.Namespace {
+	info := ainative.Namespace{Name: name, VectorCount: int64(len(vectors))}
+	if len(vectors) > 0 {
+		info.Dimension = len(vectors[0].item.Vector)
+	}
+	return info
+}
+
+// setNamespace replaces the vectors of a namespace, removing it when vectors
+// is empty; callers must hold s.mu
+func (s *Server) setNamespace(project *ainative.Project, name string, vectors []*storedVector) {
+	namespaces := s.vectors[project.ID]
+	if namespaces == nil {
+		namespaces = make(map[string][]*storedVector)
+		s.vectors[project.ID] = namespaces
+	}
+
+	if project.Stats != nil {
+		project.Stats.VectorCount += int64(len(vectors) - len(namespaces[name]))
+	}
+	if len(vectors) == 0 {
+		delete(namespaces, name)
+		return
+	}
+	namespaces[name] = vectors
+}
+
+// namespaceOr404 returns the vectors of a non-empty namespace, writing a 404
+// if it is missing; callers must hold s.mu
+func (s *Server) namespaceOr404(w http.ResponseWriter, project *ainative.Project, name string) []*storedVector {
+	vectors := s.vectors[project.ID][name]
+	if len(vectors) == 0 {
+		writeError(w, http.StatusNotFound, "Namespace not found")
+	}
+	return vectors
+}
+
+// listNamespaces handles GET /api/v1/zerodb/projects/{id}/namespaces
+func (s *Server) listNamespaces(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	prefix := r.URL.Query().Get("prefix")
+	var names []string
+	for name, vectors := range s.vectors[project.ID] {
+		if len(vectors) > 0 && strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	namespaces := make([]ainative.Namespace, len(names))
+	for i, name := range names {
+		namespaces[i] = namespaceInfo(name, s.vectors[project.ID][name])
+	}
+
+	limit, offset := pageBounds(r)
+	writeJSON(w, http.StatusOK, ainative.ListNamespacesResponse{
+		Namespaces: paginate(namespaces, limit, offset),
+		TotalCount: len(namespaces),
+		Limit:      limit,
+		Offset:     offset,
+	})
+}
+
+// describeNamespace handles GET /api/v1/zerodb/projects/{id}/namespaces/{name}
+func (s *Server) describeNamespace(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+	if vectors := s.namespaceOr404(w, project, params[1]); vectors != nil {
+		writeJSON(w, http.StatusOK, namespaceInfo(params[1], vectors))
+	}
+}
+
+// deleteNamespace handles DELETE /api/v1/zerodb/projects/{id}/namespaces/{name}
+func (s *Server) deleteNamespace(w http.ResponseWriter, r *http.Request, params []string) {
+	project := s.projectOr404(w, params[0])
+	if project == nil {
+		return
+	}
+
+	vectors := s.namespaceOr404(w, project, params[1])
+	if vectors == nil {
+		return
+	}
+	s.setNamespace(project, params[1], nil)
+
+	writeJSON(w, http.StatusOK, ainative.DeleteVectorsResponse{DeletedCount: len(vectors), Namespace: params[1]})
+}
+
+// copyNamespace handles POST /api/v1/zerodb/projects/{id}/namespaces/{name}/copy
+func (s *Server) copyNamespace(w http.ResponseWriter, r *http.Request, params []string) {
+	var req ainative.CopyNamespaceRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	s.transferNamespace(w, params[0], params[1], req.Destination, req.Overwrite, false)
+}
+
+// renameNamespace handles POST
+// /api/v1/zerodb/projects/{id}/namespaces/{name}/rename
+func (s *Server) renameNamespace(w http.ResponseWriter, r *http.Request, params []string) {
+	var req ainative.RenameNamespaceRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	s.transferNamespace(w, params[0], params[1], req.Name, req.Overwrite, true)
+}
+
+// transferNamespace copies or moves the vectors of namespace source to
+// destination
+func (s *Server) transferNamespace(w http.ResponseWriter, projectID, source, destination string, overwrite, move bool) {
+	project := s.projectOr404(w, projectID)
+	if project == nil {
+		return
+	}
+	if destination == "" || destination == source {
+		writeValidationError(w, "destination", "must differ from the source namespace")
+		return
+	}
+
+	vectors := s.namespaceOr404(w, project, source)
+	if vectors == nil {
+		return
+	}
+	if len(s.vectors[project.ID][destination]) > 0 && !overwrite {
+		writeError(w, http.StatusConflict, "Namespace "+destination+" already exists")
+		return
+	}
+
+	copies := make([]*storedVector, len(vectors))
+	for i, vector := range vectors {
+		copied := *vector
+		copies[i] = &copied
+	}
+	s.setNamespace(project, destination, copies)
+	if move {
+		s.setNamespace(project, source, nil)
+	}
+
+	writeJSON(w, http.StatusOK, namespaceInfo(destination, copies))
+}
+
+// createMemory handles POST /api/v1/memory
+func (s *Server) createMemory(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.CreateMemoryRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Content == "" {
+		writeValidationError(w, "content", "field required")
+		return
+	}
+	if req.Priority == "" {
+		req.Priority = ainative.MemoryPriorityMedium
+	}
+
+	created := now()
+	memory := &ainative.MemoryItem{
+		ID:        s.newID("mem"),
+		Content:   req.Content,
+		Title:     req.Title,
+		Tags:      req.Tags,
+		Priority:  req.Priority,
+		Metadata:  req.Metadata,
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+	s.memories = append(s.memories, memory)
+
+	writeJSON(w, http.StatusCreated, memory)
+}
+
+// searchMemory handles POST /api/v1/memory/search. Semantic searches rank
+// memories by embedding similarity; other searches match the query as a
+// case-insensitive substring of the title or content.
+func (s *Server) searchMemory(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req ainative.SearchMemoryRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Query == "" {
+		writeValidationError(w, "query", "field required")
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	query := strings.ToLower(req.Query)
+	embedding := Embed(req.Query)
+
+	type scoredMemory struct {
+		memory *ainative.MemoryItem
+		score  float64
+	}
+	var matches []scoredMemory
+	for _, memory := range s.memories {
+		if req.Priority != "" && memory.Priority != req.Priority {
+			continue
+		}
+		if len(req.Tags) > 0 && !hasAnyTag(memory.Tags, req.Tags) {
+			continue
+		}
+
+		if req.Semantic {
+			matches = append(matches, scoredMemory{memory, cosine(embedding, Embed(memory.Title+" "+memory.Content))})
+		} else if strings.Contains(strings.ToLower(memory.Content), query) ||
+			strings.Contains(strings.ToLower(memory.Title), query) {
+			matches = append(matches, scoredMemory{memory: memory})
+		}
+	}
+
+	if req.Semantic {
+		sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+	}
+
+	results := make([]ainative.MemoryItem, 0, len(matches))
+	for _, match := range matches {
+		if len(results) == req.Limit {
+			break
+		}
+		results = append(results, *match.memory)
+	}
+
+	writeJSON(w, http.StatusOK, ainative.SearchMemoryResponse{Results: results, Total: len(matches)})
+}
+
+// hasAnyTag reports whether tags contains any of want
+func hasAnyTag(tags, want []string) bool {
+	for _, tag := range tags {
+		for _, w := range want {
+			if tag == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseFilter parses a metadata filter document, writing a validation error
+// for field if it is invalid
+func parseFilter(w http.ResponseWriter, field string, doc map[string]interface{}) (filter.Filter, bool) {
+	where, err := filter.Parse(doc)
+	if err != nil {
+		writeValidationError(w, field, err.Error())
+		return filter.Filter{}, false
+	}
+	return where, true
+}
+
+// cosine returns the cosine similarity of a and b, or 0 when their lengths
+// differ or either is zero
+func cosine(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
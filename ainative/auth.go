@@ -33,6 +33,17 @@ type TokenResponse struct {
 	ExpiresAt    time.Time `json:"expires_at"`
 }
 
+// setExpiry fills in IssuedAt and, when the server sent expires_in but no
+// expires_at, ExpiresAt. A zero ExpiresAt means the token does not expire.
+func (t *TokenResponse) setExpiry(now time.Time) {
+	if t.IssuedAt.IsZero() {
+		t.IssuedAt = now
+	}
+	if t.ExpiresAt.IsZero() && t.ExpiresIn > 0 {
+		t.ExpiresAt = t.IssuedAt.Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+}
+
 // LoginRequest represents a login request
 type LoginRequest struct {
 	Username string `json:"username"`
@@ -101,13 +112,12 @@ func (s *AuthService) Login(ctx context.Context, req *LoginRequest) (*TokenRespo
 
 	var result TokenResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/auth/login", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/auth/login"), req, &result)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calculate expiration time
-	result.ExpiresAt = result.IssuedAt.Add(time.Duration(result.ExpiresIn) * time.Second)
+	result.setExpiry(time.Now())
 
 	return &result, nil
 }
@@ -124,13 +134,12 @@ func (s *AuthService) RefreshToken(ctx context.Context, req *RefreshTokenRequest
 
 	var result TokenResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/auth/refresh", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/auth/refresh"), req, &result)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calculate expiration time
-	result.ExpiresAt = result.IssuedAt.Add(time.Duration(result.ExpiresIn) * time.Second)
+	result.setExpiry(time.Now())
 
 	return &result, nil
 }
@@ -139,7 +148,7 @@ func (s *AuthService) RefreshToken(ctx context.Context, req *RefreshTokenRequest
 func (s *AuthService) GetUserInfo(ctx context.Context) (*UserInfo, error) {
 	var result UserInfo
 
-	err := s.client.makeRequest(ctx, "GET", "/api/v1/auth/me", nil, &result)
+	err := s.client.makeRequest(ctx, "GET", endpoint("/api/v1/auth/me"), nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +162,7 @@ func (s *AuthService) ListAPIKeys(ctx context.Context) ([]APIKeyInfo, error) {
 		APIKeys []APIKeyInfo `json:"api_keys"`
 	}
 
-	err := s.client.makeRequest(ctx, "GET", "/api/v1/auth/api-keys", nil, &result)
+	err := s.client.makeRequest(ctx, "GET", endpoint("/api/v1/auth/api-keys"), nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +182,7 @@ func (s *AuthService) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest
 
 	var result CreateAPIKeyResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/auth/api-keys", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/auth/api-keys"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +196,7 @@ func (s *AuthService) RevokeAPIKey(ctx context.Context, keyID string) error {
 		return NewValidationError("key_id", "key ID is required", keyID)
 	}
 
-	path := fmt.Sprintf("/api/v1/auth/api-keys/%s", keyID)
+	path := endpoint("/api/v1/auth/api-keys/%s", keyID)
 
 	return s.client.makeRequest(ctx, "DELETE", path, nil, nil)
 }
@@ -200,7 +209,7 @@ func (s *AuthService) GetAPIKeyInfo(ctx context.Context, keyID string) (*APIKeyI
 
 	var result APIKeyInfo
 
-	path := fmt.Sprintf("/api/v1/auth/api-keys/%s", keyID)
+	path := endpoint("/api/v1/auth/api-keys/%s", keyID)
 
 	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
 	if err != nil {
@@ -222,7 +231,7 @@ func (s *AuthService) ValidateToken(ctx context.Context, token string) (*UserInf
 
 	var result UserInfo
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/auth/validate", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/auth/validate"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +241,7 @@ func (s *AuthService) ValidateToken(ctx context.Context, token string) (*UserInf
 
 // Logout logs out the current user (invalidates tokens)
 func (s *AuthService) Logout(ctx context.Context) error {
-	return s.client.makeRequest(ctx, "POST", "/api/v1/auth/logout", nil, nil)
+	return s.client.makeRequest(ctx, "POST", endpoint("/api/v1/auth/logout"), nil, nil)
 }
 
 // TokenClaims represents the claims in a JWT token
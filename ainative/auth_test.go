@@ -478,4 +478,23 @@ func TestGetTokenExpirationTime(t *testing.T) {
 	// Test with invalid token
 	_, err = GetTokenExpirationTime("invalid_token")
 	assert.Error(t, err)
-}
\ No newline at end of file
+}
+func TestTokenResponse_SetExpiry(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	token := &TokenResponse{ExpiresIn: 3600}
+	token.setExpiry(now)
+	assert.Equal(t, now, token.IssuedAt)
+	assert.Equal(t, now.Add(time.Hour), token.ExpiresAt)
+
+	// Without expires_in the token does not expire
+	token = &TokenResponse{}
+	token.setExpiry(now)
+	assert.True(t, token.ExpiresAt.IsZero())
+
+	// An expires_at sent by the server is kept
+	serverExpiry := now.Add(10 * time.Minute)
+	token = &TokenResponse{ExpiresIn: 3600, ExpiresAt: serverExpiry}
+	token.setExpiry(now)
+	assert.Equal(t, serverExpiry, token.ExpiresAt)
+}
@@ -0,0 +1,371 @@
+package ainative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Bulk upsert defaults
+const (
+	DefaultBulkBatchSize   = 100
+	DefaultBulkBatchBytes  = 2 << 20
+	DefaultBulkConcurrency = 4
+	DefaultBulkRetries     = 3
+	DefaultBulkRetryDelay  = time.Second
+)
+
+// bulkRequestOverhead approximates the bytes of an upsert request outside its
+// vectors
+const bulkRequestOverhead = 64
+
+// BulkUpsertOptions configures BulkUpsert and BulkUpsertStream
+type BulkUpsertOptions struct {
+	// Namespace to upsert into
+	Namespace string
+
+	// Maximum vectors per request (defaults to DefaultBulkBatchSize)
+	BatchSize int
+
+	// Maximum encoded size of a request in bytes (defaults to
+	// DefaultBulkBatchBytes). A single vector larger than this is sent on
+	// its own.
+	MaxBatchBytes int
+
+	// Number of requests in flight at once (defaults to
+	// DefaultBulkConcurrency). Requests still pass through the client's rate
+	// limiter.
+	Concurrency int
+
+	// Attempts per batch after a retryable failure, on top of the client's
+	// own retries (defaults to DefaultBulkRetries; negative disables)
+	MaxRetries int
+
+	// Delay before the first batch retry, doubled for each further attempt
+	// (defaults to DefaultBulkRetryDelay)
+	RetryDelay time.Duration
+
+	// OnProgress is called after each batch finishes, from the calling
+	// goroutine
+	OnProgress func(BulkUpsertProgress)
+}
+
+// BulkUpsertProgress reports the progress of a bulk upsert
+type BulkUpsertProgress struct {
+	// Batches sent, successfully or not
+	Batches int
+
+	// Vectors upserted so far
+	Upserted int
+
+	// Vectors that failed so far
+	Failed int
+
+	// Total vectors to upsert, or 0 when streaming from a channel
+	Total int
+}
+
+// BulkUpsertFailure lists vectors that could not be upserted and why
+type BulkUpsertFailure struct {
+	IDs []string
+	Err error
+}
+
+// BulkUpsertResult summarizes a bulk upsert
+type BulkUpsertResult struct {
+	Upserted int
+	Batches  int
+	Failures []BulkUpsertFailure
+}
+
+// FailedIDs returns the IDs of every vector that failed
+func (r *BulkUpsertResult) FailedIDs() []string {
+	var ids []string
+	for _, failure := range r.Failures {
+		ids = append(ids, failure.IDs...)
+	}
+	return ids
+}
+
+// BulkUpsertError is returned when some vectors of a bulk upsert failed. The
+// result returned alongside it lists the failures.
+type BulkUpsertError struct {
+	Failed   int
+	Failures []BulkUpsertFailure
+}
+
+// Error implements the error interface
+func (e *BulkUpsertError) Error() string {
+	return fmt.Sprintf("ainative: %d vectors failed to upsert (first error: %v)", e.Failed, e.Failures[0].Err)
+}
+
+// Unwrap returns the error of each failure, so errors.Is matches any of them
+func (e *BulkUpsertError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure.Err
+	}
+	return errs
+}
+
+// BulkUpsert upserts any number of vectors, split into batches bounded by
+// opts.BatchSize and opts.MaxBatchBytes and sent by a pool of
+// opts.Concurrency workers.
+//
+// Batches failing with a retryable error (5xx, 429 or network errors) are
+// retried with backoff. Batches rejected as invalid (400, 413 or 422) are
+// split in half until the offending vectors are isolated, so only they are
+// reported as failed. Vectors without an ID or values fail without being
+// sent.
+//
+// Each batch is a request of its own: a key set with WithIdempotencyKey and
+// a ResponseMeta set with WithResponseMeta are not applied to the batches.
+//
+// The result is always returned. The error is a *BulkUpsertError when some
+// vectors failed, or the context's error when ctx was canceled; vectors not
+// sent because of the cancellation are reported as failed.
+//
+// Example:
+//
+//	result, err := client.ZeroDB.Vectors.BulkUpsert(ctx, projectID, vectors, &ainative.BulkUpsertOptions{
+//	    OnProgress: func(p ainative.BulkUpsertProgress) {
+//	        log.Printf("%d/%d upserted", p.Upserted, p.Total)
+//	    },
+//	})
+//	if err != nil {
+//	    log.Printf("failed IDs: %v", result.FailedIDs())
+//	}
+func (s *VectorsService) BulkUpsert(ctx context.Context, projectID string, vectors []VectorItem, opts *BulkUpsertOptions) (*BulkUpsertResult, error) {
+	i := 0
+	next := func() (VectorItem, bool) {
+		if i == len(vectors) {
+			return VectorItem{}, false
+		}
+		i++
+		return vectors[i-1], true
+	}
+	return s.bulkUpsert(ctx, projectID, next, len(vectors), opts)
+}
+
+// BulkUpsertStream is like BulkUpsert, reading vectors from a channel until
+// it is closed. When ctx is canceled it stops reading; vectors still in the
+// channel are neither sent nor reported.
+func (s *VectorsService) BulkUpsertStream(ctx context.Context, projectID string, vectors <-chan VectorItem, opts *BulkUpsertOptions) (*BulkUpsertResult, error) {
+	next := func() (VectorItem, bool) {
+		select {
+		case item, ok := <-vectors:
+			return item, ok
+		case <-ctx.Done():
+			return VectorItem{}, false
+		}
+	}
+	return s.bulkUpsert(ctx, projectID, next, 0, opts)
+}
+
+// bulkBatch is a batch of vectors and the outcome of sending it
+type bulkBatch struct {
+	vectors  []VectorItem
+	upserted int
+	failures []BulkUpsertFailure
+}
+
+// bulkUpsert batches the vectors returned by next and upserts them
+func (s *VectorsService) bulkUpsert(ctx context.Context, projectID string, next func() (VectorItem, bool), total int, opts *BulkUpsertOptions) (*BulkUpsertResult, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+
+	// Batches are sent concurrently, each with its own idempotency key
+	batchCtx := detachCallValues(ctx)
+
+	options := BulkUpsertOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = DefaultBulkBatchSize
+	}
+	if options.MaxBatchBytes <= 0 {
+		options.MaxBatchBytes = DefaultBulkBatchBytes
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultBulkConcurrency
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = DefaultBulkRetries
+	}
+	if options.RetryDelay <= 0 {
+		options.RetryDelay = DefaultBulkRetryDelay
+	}
+
+	batches := make(chan *bulkBatch)
+	results := make(chan *bulkBatch)
+
+	// Producer: split the input into bounded batches. Invalid vectors are
+	// reported directly as single-vector batches that are never sent.
+	go func() {
+		defer close(batches)
+
+		var batch []VectorItem
+		size := bulkRequestOverhead
+		flush := func() {
+			if len(batch) > 0 {
+				batches <- &bulkBatch{vectors: batch}
+				batch, size = nil, bulkRequestOverhead
+			}
+		}
+
+		for {
+			item, ok := next()
+			if !ok {
+				break
+			}
+
+			if err := validateBulkVector(item); err != nil {
+				results <- &bulkBatch{failures: []BulkUpsertFailure{{IDs: []string{item.ID}, Err: err}}}
+				continue
+			}
+
+			itemSize := encodedSize(item, s.client.compactVectors())
+			if len(batch) == options.BatchSize || (len(batch) > 0 && size+itemSize > options.MaxBatchBytes) {
+				flush()
+			}
+			batch = append(batch, item)
+			size += itemSize
+		}
+		flush()
+	}()
+
+	// Workers: send batches
+	var workers sync.WaitGroup
+	for i := 0; i < options.Concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for batch := range batches {
+				s.sendBulkBatch(batchCtx, projectID, &options, batch, batch.vectors)
+				results <- batch
+			}
+		}()
+	}
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	// Collect results and report progress from the calling goroutine
+	result := &BulkUpsertResult{}
+	progress := BulkUpsertProgress{Total: total}
+	for batch := range results {
+		if len(batch.vectors) > 0 {
+			result.Batches++
+		}
+		result.Upserted += batch.upserted
+		result.Failures = append(result.Failures, batch.failures...)
+
+		progress.Batches = result.Batches
+		progress.Upserted = result.Upserted
+		for _, failure := range batch.failures {
+			progress.Failed += len(failure.IDs)
+		}
+		if options.OnProgress != nil {
+			options.OnProgress(progress)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+	if len(result.Failures) > 0 {
+		return result, &BulkUpsertError{Failed: progress.Failed, Failures: result.Failures}
+	}
+	return result, nil
+}
+
+// sendBulkBatch upserts vectors, retrying retryable errors and splitting the
+// vectors to isolate those rejected as invalid. Outcomes are added to batch.
+func (s *VectorsService) sendBulkBatch(ctx context.Context, projectID string, opts *BulkUpsertOptions, batch *bulkBatch, vectors []VectorItem) {
+	delay := opts.RetryDelay
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			batch.failures = append(batch.failures, BulkUpsertFailure{IDs: vectorIDs(vectors), Err: err})
+			return
+		}
+
+		resp, err := s.Upsert(ctx, projectID, &UpsertVectorsRequest{Vectors: vectors, Namespace: opts.Namespace})
+		if err == nil {
+			batch.upserted += resp.UpsertedCount
+			return
+		}
+
+		if len(vectors) > 1 && isRejectedBatch(err) {
+			half := len(vectors) / 2
+			s.sendBulkBatch(ctx, projectID, opts, batch, vectors[:half])
+			s.sendBulkBatch(ctx, projectID, opts, batch, vectors[half:])
+			return
+		}
+
+		if attempt >= opts.MaxRetries || !isRetryableError(err) {
+			batch.failures = append(batch.failures, BulkUpsertFailure{IDs: vectorIDs(vectors), Err: err})
+			return
+		}
+
+		select {
+		case <-time.After(delay):
+			delay *= 2
+		case <-ctx.Done():
+		}
+	}
+}
+
+// validateBulkVector checks a vector before it is batched
+func validateBulkVector(item VectorItem) error {
+	if item.ID == "" {
+		return NewValidationError("id", "vector ID is required", item.ID)
+	}
+	if len(item.Vector) == 0 && len(item.Vector32) == 0 {
+		return NewValidationError("vector", "vector cannot be empty", item.Vector)
+	}
+	return nil
+}
+
+// encodedSize returns the size of item in an upsert request body
+func encodedSize(item VectorItem, compact bool) int {
+	data, err := item.marshalJSON(compact)
+	if err != nil {
+		return 0
+	}
+	return len(data) + 1
+}
+
+// vectorIDs returns the IDs of vectors
+func vectorIDs(vectors []VectorItem) []string {
+	ids := make([]string, len(vectors))
+	for i, vector := range vectors {
+		ids[i] = vector.ID
+	}
+	return ids
+}
+
+// isRejectedBatch reports whether err means the request content was
+// rejected, so a smaller batch may succeed
+func isRejectedBatch(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusRequestEntityTooLarge {
+		return true
+	}
+	return errors.Is(err, ErrInvalidRequest)
+}
+
+// isRetryableError reports whether retrying the request may succeed
+func isRetryableError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsRetryable()
+	}
+	return errors.Is(err, ErrNetwork) || errors.Is(err, ErrTimeout)
+}
@@ -0,0 +1,254 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bulkServer is an upsert endpoint that records the batches it receives
+type bulkServer struct {
+	mu      sync.Mutex
+	batches [][]string
+
+	// handle may reject a batch by writing an error response
+	handle func(w http.ResponseWriter, ids []string) bool
+}
+
+func (b *bulkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req UpsertVectorsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ids := make([]string, len(req.Vectors))
+	for i, vector := range req.Vectors {
+		ids[i] = vector.ID
+	}
+
+	b.mu.Lock()
+	b.batches = append(b.batches, ids)
+	b.mu.Unlock()
+
+	if b.handle != nil && b.handle(w, ids) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(UpsertVectorsResponse{UpsertedCount: len(ids), Namespace: req.Namespace})
+}
+
+// newBulkClient returns a client for srv without client-level retries
+func newBulkClient(t *testing.T, srv http.Handler) *Client {
+	server := httptest.NewServer(srv)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(&Config{
+		APIKey:      "test-key",
+		BaseURL:     server.URL,
+		RateLimit:   10000,
+		RetryConfig: &RetryConfig{MaxRetries: 0},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+// testVectors returns n vectors with IDs vec_0 ... vec_{n-1}
+func testVectors(n int) []VectorItem {
+	vectors := make([]VectorItem, n)
+	for i := range vectors {
+		vectors[i] = VectorItem{ID: fmt.Sprintf("vec_%d", i), Vector: []float64{float64(i), 1}}
+	}
+	return vectors
+}
+
+func TestBulkUpsert_Batching(t *testing.T) {
+	srv := &bulkServer{}
+	client := newBulkClient(t, srv)
+
+	var progress []BulkUpsertProgress
+	result, err := client.ZeroDB.Vectors.BulkUpsert(context.Background(), "proj_123", testVectors(25), &BulkUpsertOptions{
+		BatchSize:   10,
+		Concurrency: 3,
+		OnProgress: func(p BulkUpsertProgress) {
+			progress = append(progress, p)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 25, result.Upserted)
+	assert.Equal(t, 3, result.Batches)
+	assert.Empty(t, result.FailedIDs())
+
+	sizes := make([]int, len(srv.batches))
+	for i, batch := range srv.batches {
+		sizes[i] = len(batch)
+	}
+	sort.Ints(sizes)
+	assert.Equal(t, []int{5, 10, 10}, sizes)
+
+	require.Len(t, progress, 3)
+	assert.Equal(t, BulkUpsertProgress{Batches: 3, Upserted: 25, Total: 25}, progress[2])
+}
+
+func TestBulkUpsert_ByteBoundedBatches(t *testing.T) {
+	srv := &bulkServer{}
+	client := newBulkClient(t, srv)
+
+	vectors := testVectors(6)
+	vectors[3].Metadata = map[string]interface{}{"text": strings.Repeat("x", 1000)}
+
+	_, err := client.ZeroDB.Vectors.BulkUpsert(context.Background(), "proj_123", vectors, &BulkUpsertOptions{
+		MaxBatchBytes: 300,
+		Concurrency:   1,
+	})
+	require.NoError(t, err)
+
+	// The oversized vector travels alone; the rest fill batches up to the
+	// byte limit
+	assert.Equal(t, [][]string{{"vec_0", "vec_1", "vec_2"}, {"vec_3"}, {"vec_4", "vec_5"}}, srv.batches)
+}
+
+func TestBulkUpsert_PartialFailures(t *testing.T) {
+	var unavailable int32
+	srv := &bulkServer{handle: func(w http.ResponseWriter, ids []string) bool {
+		for _, id := range ids {
+			switch id {
+			case "vec_3":
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"detail": "dimension mismatch"}`))
+				return true
+			case "vec_7":
+				// Fail the first attempt only
+				if atomic.AddInt32(&unavailable, 1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return true
+				}
+			}
+		}
+		return false
+	}}
+	client := newBulkClient(t, srv)
+
+	vectors := append(testVectors(10), VectorItem{ID: "empty"}, VectorItem{Vector: []float64{1}})
+	result, err := client.ZeroDB.Vectors.BulkUpsert(context.Background(), "proj_123", vectors, &BulkUpsertOptions{
+		BatchSize:  5,
+		RetryDelay: time.Millisecond,
+	})
+
+	var bulkErr *BulkUpsertError
+	require.True(t, errors.As(err, &bulkErr))
+	assert.Equal(t, 3, bulkErr.Failed)
+	assert.True(t, errors.Is(err, ErrInvalidRequest))
+	assert.True(t, errors.Is(err, ErrValidation))
+
+	assert.Equal(t, 9, result.Upserted)
+	assert.ElementsMatch(t, []string{"vec_3", "empty", ""}, result.FailedIDs())
+	for _, failure := range result.Failures {
+		if failure.IDs[0] == "vec_3" {
+			assert.Contains(t, failure.Err.Error(), "dimension mismatch")
+		}
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&unavailable))
+}
+
+func TestBulkUpsert_RetriesExhausted(t *testing.T) {
+	srv := &bulkServer{handle: func(w http.ResponseWriter, ids []string) bool {
+		w.WriteHeader(http.StatusBadGateway)
+		return true
+	}}
+	client := newBulkClient(t, srv)
+
+	result, err := client.ZeroDB.Vectors.BulkUpsert(context.Background(), "proj_123", testVectors(3), &BulkUpsertOptions{
+		MaxRetries: 2,
+		RetryDelay: time.Millisecond,
+	})
+	assert.True(t, errors.Is(err, ErrServerError))
+	assert.Len(t, srv.batches, 3)
+	assert.Equal(t, []string{"vec_0", "vec_1", "vec_2"}, result.FailedIDs())
+}
+
+func TestBulkUpsert_CallValues(t *testing.T) {
+	var keys sync.Map
+	srv := &bulkServer{}
+	server := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys.Store(r.Header.Get(HeaderIdempotencyKey), true)
+		srv.ServeHTTP(w, r)
+	})
+	client := newBulkClient(t, server)
+
+	// The caller's key and ResponseMeta must not be shared by concurrent
+	// batches; run with -race
+	var meta ResponseMeta
+	ctx := WithResponseMeta(WithIdempotencyKey(context.Background(), "bulk-load-1"), &meta)
+	result, err := client.ZeroDB.Vectors.BulkUpsert(ctx, "proj_123", testVectors(40), &BulkUpsertOptions{
+		BatchSize:   5,
+		Concurrency: 4,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 40, result.Upserted)
+
+	count := 0
+	keys.Range(func(key, _ interface{}) bool {
+		assert.NotEqual(t, "bulk-load-1", key)
+		count++
+		return true
+	})
+	assert.Equal(t, 8, count)
+	assert.Equal(t, ResponseMeta{}, meta)
+}
+
+func TestBulkUpsertStream(t *testing.T) {
+	srv := &bulkServer{}
+	client := newBulkClient(t, srv)
+
+	vectors := make(chan VectorItem)
+	go func() {
+		defer close(vectors)
+		for _, vector := range testVectors(7) {
+			vectors <- vector
+		}
+	}()
+
+	var last BulkUpsertProgress
+	result, err := client.ZeroDB.Vectors.BulkUpsertStream(context.Background(), "proj_123", vectors, &BulkUpsertOptions{
+		BatchSize:  3,
+		OnProgress: func(p BulkUpsertProgress) { last = p },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 7, result.Upserted)
+	assert.Equal(t, 3, result.Batches)
+	assert.Equal(t, BulkUpsertProgress{Batches: 3, Upserted: 7}, last)
+}
+
+func TestBulkUpsert_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := &bulkServer{handle: func(w http.ResponseWriter, ids []string) bool {
+		cancel()
+		return false
+	}}
+	client := newBulkClient(t, srv)
+
+	result, err := client.ZeroDB.Vectors.BulkUpsert(ctx, "proj_123", testVectors(20), &BulkUpsertOptions{
+		BatchSize:   5,
+		Concurrency: 1,
+	})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, 20, result.Upserted+len(result.FailedIDs()))
+	assert.Less(t, len(srv.batches), 4)
+
+	_, err = client.ZeroDB.Vectors.BulkUpsert(context.Background(), "", testVectors(1), nil)
+	assert.True(t, errors.Is(err, ErrValidation))
+}
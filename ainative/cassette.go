@@ -0,0 +1,389 @@
+package ainative
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrNoInteraction is returned by a replaying Cassette when no recorded
+// interaction matches a request
+var ErrNoInteraction = errors.New("ainative: no matching cassette interaction")
+
+// CassetteMode selects whether a Cassette records or replays traffic
+type CassetteMode int
+
+const (
+	// CassetteReplay serves responses from the cassette file and never
+	// touches the network
+	CassetteReplay CassetteMode = iota
+
+	// CassetteRecord sends requests to the real API and records them,
+	// replacing any existing cassette when saved
+	CassetteRecord
+
+	// CassetteAuto replays when the cassette file exists and records
+	// otherwise
+	CassetteAuto
+)
+
+// CassetteOptions configures a Cassette
+type CassetteOptions struct {
+	// Mode selects recording or replay (defaults to CassetteReplay)
+	Mode CassetteMode
+
+	// Transport used to reach the API while recording (defaults to
+	// http.DefaultTransport)
+	Transport http.RoundTripper
+
+	// Secrets are scrubbed from recorded URLs, headers and bodies, e.g. the
+	// Config.APISecret. Authorization and other credential headers are always
+	// scrubbed.
+	Secrets []string
+}
+
+// Cassette is an http.RoundTripper that records SDK traffic to a file and
+// replays it later, so tests can exercise realistic flows offline.
+//
+// Requests are matched by method, path, query string and JSON body. The host
+// is ignored, query parameters may come in any order and bodies are compared
+// after normalizing key order and whitespace; secrets are scrubbed before
+// comparing. Each recorded interaction is replayed
+// once, in order; when all matching interactions have been used, the last one
+// is replayed again.
+//
+// Example:
+//
+//	cassette, err := ainative.NewCassette("testdata/search.json", &ainative.CassetteOptions{
+//	    Mode: ainative.CassetteAuto,
+//	})
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	defer cassette.Save()
+//
+//	client, err := ainative.NewClient(&ainative.Config{
+//	    APIKey:     os.Getenv("AINATIVE_API_KEY"),
+//	    HTTPClient: cassette.HTTPClient(),
+//	})
+type Cassette struct {
+	path      string
+	mode      CassetteMode
+	transport http.RoundTripper
+	secrets   []string
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// cassetteFile is the on-disk form of a cassette
+type cassetteFile struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// cassetteVersion is the current cassette file format
+const cassetteVersion = 1
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the scrubbed form of a recorded request
+type RecordedRequest struct {
+	Method string              `json:"method"`
+	URL    string              `json:"url"`
+	Route  string              `json:"route,omitempty"`
+	Header map[string][]string `json:"header,omitempty"`
+	Body   json.RawMessage     `json:"body,omitempty"`
+	Text   string              `json:"text,omitempty"`
+}
+
+// RecordedResponse is the scrubbed form of a recorded response
+type RecordedResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Header     map[string][]string `json:"header,omitempty"`
+	Body       json.RawMessage     `json:"body,omitempty"`
+	Text       string              `json:"text,omitempty"`
+}
+
+// NewCassette opens the cassette at path. Replay mode requires the file to
+// exist; record mode starts empty and writes the file on Save.
+func NewCassette(path string, opts *CassetteOptions) (*Cassette, error) {
+	if opts == nil {
+		opts = &CassetteOptions{}
+	}
+
+	c := &Cassette{
+		path:      path,
+		mode:      opts.Mode,
+		transport: opts.Transport,
+		secrets:   opts.Secrets,
+	}
+	if c.transport == nil {
+		c.transport = http.DefaultTransport
+	}
+
+	if c.mode == CassetteAuto {
+		c.mode = CassetteRecord
+		if _, err := os.Stat(path); err == nil {
+			c.mode = CassetteReplay
+		}
+	}
+
+	if c.mode == CassetteReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+		if file.Version != cassetteVersion {
+			return nil, fmt.Errorf("unsupported cassette version %d in %s", file.Version, path)
+		}
+
+		// Bodies are stored indented and may be edited by hand; normalize
+		// request bodies the same way as incoming requests
+		for _, interaction := range file.Interactions {
+			if len(interaction.Request.Body) > 0 {
+				interaction.Request.Body, _ = c.scrubBody(interaction.Request.Body)
+			}
+			if len(interaction.Response.Body) > 0 {
+				var compact bytes.Buffer
+				if err := json.Compact(&compact, interaction.Response.Body); err != nil {
+					return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+				}
+				interaction.Response.Body = compact.Bytes()
+			}
+		}
+
+		c.interactions = file.Interactions
+		c.used = make([]bool, len(file.Interactions))
+	}
+
+	return c, nil
+}
+
+// Mode returns the cassette's mode. CassetteAuto is resolved to record or
+// replay when the cassette is opened.
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// HTTPClient returns an HTTP client using the cassette, for Config.HTTPClient
+func (c *Cassette) HTTPClient() *http.Client {
+	return &http.Client{Transport: c}
+}
+
+// Interactions returns the recorded interactions
+func (c *Cassette) Interactions() []*Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*Interaction(nil), c.interactions...)
+}
+
+// Save writes the recorded interactions to the cassette file, creating its
+// directory if needed. It does nothing in replay mode.
+func (c *Cassette) Save() error {
+	if c.mode != CassetteRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Version: cassetteVersion, Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.mode == CassetteReplay {
+		return c.replay(req, body)
+	}
+	return c.record(req, body)
+}
+
+// record sends req to the API and stores the scrubbed interaction
+func (c *Cassette) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := c.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    c.scrub(req.URL.String()),
+			Route:  routeFromContext(req.Context()),
+			Header: c.scrubHeader(req.Header),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     c.scrubHeader(resp.Header),
+		},
+	}
+	interaction.Request.Body, interaction.Request.Text = c.scrubBody(body)
+	interaction.Response.Body, interaction.Response.Text = c.scrubBody(respBody)
+
+	c.mu.Lock()
+	c.interactions = append(c.interactions, interaction)
+	c.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay serves the recorded response matching req
+func (c *Cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	target := c.matchURL(req.URL)
+	reqBody, reqText := c.scrubBody(body)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	match := -1
+	for i, interaction := range c.interactions {
+		recorded := interaction.Request
+		if recorded.Method != req.Method || !bytes.Equal(recorded.Body, reqBody) || recorded.Text != reqText {
+			continue
+		}
+		if u, err := url.Parse(recorded.URL); err != nil || c.matchURL(u) != target {
+			continue
+		}
+		match = i
+		if !c.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, target)
+	}
+	c.used[match] = true
+
+	recorded := c.interactions[match].Response
+	header := http.Header(recorded.Header).Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	respBody := []byte(recorded.Text)
+	if recorded.Body != nil {
+		respBody = recorded.Body
+		if header.Get("Content-Type") == "" {
+			header.Set("Content-Type", "application/json")
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// matchURL returns the scrubbed path and query of u, with query parameters
+// sorted, for matching requests against recordings
+func (c *Cassette) matchURL(u *url.URL) string {
+	query := u.Query()
+	for key, values := range query {
+		for i, value := range values {
+			values[i] = c.scrub(value)
+		}
+		query[key] = values
+	}
+
+	path := c.scrub(u.Path)
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
+}
+
+// scrub replaces the configured secrets in s
+func (c *Cassette) scrub(s string) string {
+	for _, secret := range c.secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, redactedValue)
+		}
+	}
+	return s
+}
+
+// scrubHeader returns a copy of header with credentials and secrets replaced
+func (c *Cassette) scrubHeader(header http.Header) map[string][]string {
+	if len(header) == 0 {
+		return nil
+	}
+
+	scrubbed := make(map[string][]string, len(header))
+	for key, values := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			scrubbed[key] = []string{redactedValue}
+			continue
+		}
+		for _, value := range values {
+			scrubbed[key] = append(scrubbed[key], c.scrub(value))
+		}
+	}
+	return scrubbed
+}
+
+// scrubBody normalizes a body for storage and matching. JSON bodies are
+// re-encoded with sorted keys and sensitive fields redacted; other bodies
+// are returned as text.
+func (c *Cassette) scrubBody(body []byte) (json.RawMessage, string) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, ""
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(body, &generic); err != nil {
+		return nil, c.scrub(string(body))
+	}
+
+	normalized, err := json.Marshal(redactValue(generic))
+	if err != nil {
+		return nil, c.scrub(string(body))
+	}
+	return json.RawMessage(c.scrub(string(normalized))), ""
+}
@@ -0,0 +1,182 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCassetteClient creates a client sending traffic through cassette
+func newCassetteClient(t *testing.T, baseURL string, cassette *Cassette) *Client {
+	client, err := NewClient(&Config{
+		APIKey:      "test-key",
+		APISecret:   "test-secret",
+		BaseURL:     baseURL,
+		HTTPClient:  cassette.HTTPClient(),
+		RetryConfig: &RetryConfig{MaxRetries: 0},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	searches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(HeaderRequestID, "req_1")
+		switch r.URL.Path {
+		case "/api/v1/zerodb/projects/proj_recorded/vectors/search":
+			searches++
+			json.NewEncoder(w).Encode(VectorSearchResponse{
+				Matches: []VectorSearchMatch{{ID: "vec_" + string(rune('0'+searches)), Score: 0.9}},
+			})
+		case "/api/v1/zerodb/projects/proj_recorded":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Project not found"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	path := filepath.Join(t.TempDir(), "cassettes", "search.json")
+	ctx := context.Background()
+	search := &VectorSearchRequest{Vector: []float64{0.1, 0.2}, TopK: 1}
+
+	recorder, err := NewCassette(path, &CassetteOptions{Mode: CassetteAuto, Secrets: []string{"test-secret"}})
+	require.NoError(t, err)
+	assert.Equal(t, CassetteRecord, recorder.Mode())
+
+	client := newCassetteClient(t, server.URL, recorder)
+	for i := 0; i < 2; i++ {
+		_, err = client.ZeroDB.Vectors.Search(ctx, "proj_recorded", search)
+		require.NoError(t, err)
+	}
+	_, err = client.ZeroDB.Projects.Get(ctx, "proj_recorded")
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.NoError(t, recorder.Save())
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "test-key")
+	assert.NotContains(t, string(data), "test-secret")
+	assert.Contains(t, string(data), "/api/v1/zerodb/projects/{id}/vectors/search")
+
+	player, err := NewCassette(path, &CassetteOptions{Mode: CassetteAuto})
+	require.NoError(t, err)
+	assert.Equal(t, CassetteReplay, player.Mode())
+
+	// The server is gone; the host is not matched
+	client = newCassetteClient(t, "http://cassette.invalid", player)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		result, err := client.ZeroDB.Vectors.Search(ctx, "proj_recorded", search)
+		require.NoError(t, err)
+		ids = append(ids, result.Matches[0].ID)
+	}
+	assert.Equal(t, []string{"vec_1", "vec_2", "vec_2"}, ids)
+
+	_, err = client.ZeroDB.Projects.Get(ctx, "proj_recorded")
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "Project not found", apiErr.Message)
+	assert.Equal(t, "req_1", apiErr.RequestID)
+}
+
+func TestCassette_URLMatching(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "version": 1,
+  "interactions": [
+    {
+      "request": {"method": "GET", "url": "https://api.ainative.studio/api/v1/zerodb/projects/proj_a"},
+      "response": {"status_code": 200, "body": {"id": "proj_a"}}
+    },
+    {
+      "request": {"method": "GET", "url": "https://api.ainative.studio/api/v1/zerodb/projects/proj_b"},
+      "response": {"status_code": 200, "body": {"id": "proj_b"}}
+    },
+    {
+      "request": {"method": "GET", "url": "https://api.ainative.studio/api/v1/zerodb/projects/proj_a/vectors/ids?prefix=doc_&offset=0&limit=100"},
+      "response": {"status_code": 200, "body": {"ids": ["doc_1"]}}
+    },
+    {
+      "request": {"method": "GET", "url": "https://api.ainative.studio/api/v1/zerodb/projects/proj_a/vectors/ids?limit=100&prefix=img_&offset=0"},
+      "response": {"status_code": 200, "body": {"ids": ["img_1"]}}
+    }
+  ]
+}`), 0o644))
+
+	cassette, err := NewCassette(path, nil)
+	require.NoError(t, err)
+	client := newCassetteClient(t, "http://cassette.invalid", cassette)
+	ctx := context.Background()
+
+	for _, id := range []string{"proj_b", "proj_a", "proj_b"} {
+		project, err := client.ZeroDB.Projects.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, project.ID)
+	}
+	_, err = client.ZeroDB.Projects.Get(ctx, "proj_c")
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+
+	// Query parameters are matched in any order
+	for prefix, want := range map[string]string{"img_": "img_1", "doc_": "doc_1"} {
+		ids, err := client.ZeroDB.Vectors.ListIDs(ctx, "proj_a", &ListVectorIDsRequest{Prefix: prefix})
+		require.NoError(t, err)
+		assert.Equal(t, []string{want}, ids.IDs)
+	}
+	_, err = client.ZeroDB.Vectors.ListIDs(ctx, "proj_a", &ListVectorIDsRequest{Prefix: "doc_", Offset: 100})
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+}
+
+func TestCassette_BodyMatching(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "version": 1,
+  "interactions": [{
+    "request": {
+      "method": "POST",
+      "url": "https://api.ainative.studio/api/v1/memory",
+      "route": "/api/v1/memory",
+      "body": {"tags": ["a"], "priority": "medium", "content": "hello"}
+    },
+    "response": {"status_code": 201, "body": {"id": "mem_1", "content": "hello"}}
+  }]
+}`), 0o644))
+
+	cassette, err := NewCassette(path, nil)
+	require.NoError(t, err)
+	client := newCassetteClient(t, "http://cassette.invalid", cassette)
+	ctx := context.Background()
+
+	// Keys are sorted when compared, so field order does not matter
+	memory, err := client.ZeroDB.Memory.Create(ctx, &CreateMemoryRequest{Content: "hello", Tags: []string{"a"}})
+	require.NoError(t, err)
+	assert.Equal(t, "mem_1", memory.ID)
+
+	_, err = client.ZeroDB.Memory.Create(ctx, &CreateMemoryRequest{Content: "goodbye", Tags: []string{"a"}})
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+
+	_, err = client.ZeroDB.Memory.Search(ctx, &SearchMemoryRequest{Query: "hello"})
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+}
+
+func TestCassette_Errors(t *testing.T) {
+	_, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), &CassetteOptions{Mode: CassetteReplay})
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "v2.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 2, "interactions": []}`), 0o644))
+	_, err = NewCassette(path, nil)
+	assert.ErrorContains(t, err, "unsupported cassette version")
+}
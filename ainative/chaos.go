@@ -0,0 +1,336 @@
+package ainative
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FaultKind identifies a fault injected by a ChaosTransport
+type FaultKind int
+
+const (
+	// FaultNone passes the request through unchanged
+	FaultNone FaultKind = iota
+
+	// FaultReset fails the request with a connection reset before it
+	// reaches the server
+	FaultReset
+
+	// FaultRateLimit answers 429 Too Many Requests with a Retry-After header
+	// without contacting the server
+	FaultRateLimit
+
+	// FaultServerError answers with a 5xx status without contacting the
+	// server
+	FaultServerError
+
+	// FaultTruncate forwards the request but cuts the response body short,
+	// failing the read with io.ErrUnexpectedEOF
+	FaultTruncate
+)
+
+// String returns the name of the fault
+func (k FaultKind) String() string {
+	switch k {
+	case FaultNone:
+		return "none"
+	case FaultReset:
+		return "reset"
+	case FaultRateLimit:
+		return "rate_limit"
+	case FaultServerError:
+		return "server_error"
+	case FaultTruncate:
+		return "truncate"
+	default:
+		return fmt.Sprintf("FaultKind(%d)", int(k))
+	}
+}
+
+// Fault is a single scripted fault
+type Fault struct {
+	// Kind of fault to inject
+	Kind FaultKind
+
+	// Latency added before the request is handled
+	Latency time.Duration
+
+	// Status for FaultServerError (defaults to 503)
+	Status int
+
+	// RetryAfter for FaultRateLimit, sent in whole seconds
+	RetryAfter time.Duration
+}
+
+// ChaosConfig configures a ChaosTransport. Faults are injected by script when
+// Script is set, and by probability otherwise.
+type ChaosConfig struct {
+	// Transport that handles requests that are not failed outright
+	// (defaults to http.DefaultTransport)
+	Transport http.RoundTripper
+
+	// Script lists the fault for each request in order; requests beyond the
+	// end of the script pass through
+	Script []Fault
+
+	// Probability of adding Latency to a request
+	LatencyProbability float64
+	Latency            time.Duration
+
+	// Probability of a connection reset
+	ResetProbability float64
+
+	// Probability of a 429 carrying RetryAfter
+	RateLimitProbability float64
+	RetryAfter           time.Duration
+
+	// Probability of starting a burst of ServerErrorBurst consecutive
+	// ServerErrorStatus responses (defaults to a single 503)
+	ServerErrorProbability float64
+	ServerErrorBurst       int
+	ServerErrorStatus      int
+
+	// Probability of truncating a response body
+	TruncateProbability float64
+
+	// Seed for the random source, for reproducible runs (defaults to the
+	// current time)
+	Seed int64
+}
+
+// ChaosTransport is an http.RoundTripper that injects latency, connection
+// resets, rate limiting, server errors and truncated bodies, to exercise
+// retry and backoff behavior under failure. Plug it into Config.HTTPClient:
+//
+//	chaos := ainative.NewChaosTransport(&ainative.ChaosConfig{
+//	    Script: []ainative.Fault{
+//	        {Kind: ainative.FaultServerError},
+//	        {Kind: ainative.FaultRateLimit, RetryAfter: time.Second},
+//	    },
+//	})
+//	client, err := ainative.NewClient(&ainative.Config{
+//	    APIKey:     "your-api-key",
+//	    HTTPClient: &http.Client{Transport: chaos},
+//	})
+//
+// Chance-based faults are checked in the order reset, rate limit, server
+// error, truncate; at most one is injected per request.
+type ChaosTransport struct {
+	config    ChaosConfig
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	rand     *rand.Rand
+	requests int
+	burst    int
+	delayed  int
+	injected map[FaultKind]int
+}
+
+// NewChaosTransport creates a fault-injecting transport
+func NewChaosTransport(config *ChaosConfig) *ChaosTransport {
+	if config == nil {
+		config = &ChaosConfig{}
+	}
+
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	t := &ChaosTransport{
+		config:    *config,
+		transport: config.Transport,
+		rand:      rand.New(rand.NewSource(seed)),
+		injected:  make(map[FaultKind]int),
+	}
+	if t.transport == nil {
+		t.transport = http.DefaultTransport
+	}
+	return t
+}
+
+// Requests returns the number of requests seen
+func (t *ChaosTransport) Requests() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.requests
+}
+
+// Injected returns the number of times kind was injected
+func (t *ChaosTransport) Injected(kind FaultKind) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.injected[kind]
+}
+
+// Delayed returns the number of requests that had latency added
+func (t *ChaosTransport) Delayed() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.delayed
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *ChaosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fault := t.nextFault()
+
+	if fault.Latency > 0 {
+		timer := time.NewTimer(fault.Latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	switch fault.Kind {
+	case FaultReset:
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+
+	case FaultRateLimit:
+		resp := chaosResponse(req, http.StatusTooManyRequests, "Rate limit exceeded (injected)")
+		seconds := int((fault.RetryAfter + time.Second - 1) / time.Second)
+		resp.Header.Set(HeaderRetryAfter, strconv.Itoa(seconds))
+		return resp, nil
+
+	case FaultServerError:
+		status := fault.Status
+		if status == 0 {
+			status = http.StatusServiceUnavailable
+		}
+		return chaosResponse(req, status, http.StatusText(status)+" (injected)"), nil
+
+	case FaultTruncate:
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = &truncatedBody{data: body[:len(body)/2]}
+		return resp, nil
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+// nextFault picks the fault for the next request
+func (t *ChaosTransport) nextFault() Fault {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	index := t.requests
+	t.requests++
+
+	var fault Fault
+	if len(t.config.Script) > 0 {
+		if index < len(t.config.Script) {
+			fault = t.config.Script[index]
+		}
+	} else {
+		fault = t.rollFault()
+	}
+
+	if fault.Latency > 0 {
+		t.delayed++
+	}
+	if fault.Kind != FaultNone {
+		t.injected[fault.Kind]++
+	}
+	return fault
+}
+
+// rollFault picks a fault by probability; callers must hold t.mu
+func (t *ChaosTransport) rollFault() Fault {
+	config := t.config
+
+	var fault Fault
+	if t.chance(config.LatencyProbability) {
+		fault.Latency = config.Latency
+	}
+
+	if t.burst > 0 {
+		t.burst--
+		fault.Kind = FaultServerError
+		fault.Status = config.ServerErrorStatus
+		return fault
+	}
+
+	switch {
+	case t.chance(config.ResetProbability):
+		fault.Kind = FaultReset
+	case t.chance(config.RateLimitProbability):
+		fault.Kind = FaultRateLimit
+		fault.RetryAfter = config.RetryAfter
+	case t.chance(config.ServerErrorProbability):
+		fault.Kind = FaultServerError
+		fault.Status = config.ServerErrorStatus
+		if config.ServerErrorBurst > 1 {
+			t.burst = config.ServerErrorBurst - 1
+		}
+	case t.chance(config.TruncateProbability):
+		fault.Kind = FaultTruncate
+	}
+	return fault
+}
+
+// chance reports true with probability p; callers must hold t.mu
+func (t *ChaosTransport) chance(p float64) bool {
+	return p > 0 && t.rand.Float64() < p
+}
+
+// chaosResponse builds an injected JSON error response
+func chaosResponse(req *http.Request, status int, detail string) *http.Response {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	body := []byte(fmt.Sprintf(`{"detail": %q}`, detail))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// truncatedBody returns its data and then fails with io.ErrUnexpectedEOF
+type truncatedBody struct {
+	data []byte
+}
+
+// Read implements io.Reader
+func (b *truncatedBody) Read(p []byte) (int, error) {
+	if len(b.data) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, b.data)
+	b.data = b.data[n:]
+	return n, nil
+}
+
+// Close implements io.Closer
+func (b *truncatedBody) Close() error {
+	return nil
+}
@@ -0,0 +1,167 @@
+package ainative
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newChaosClient creates a client for server that sends requests through chaos
+func newChaosClient(t *testing.T, server *httptest.Server, chaos *ChaosTransport, maxRetries int, middleware ...Middleware) *Client {
+	client, err := NewClient(&Config{
+		Middleware: middleware,
+		APIKey:     "test-key",
+		BaseURL:    server.URL,
+		HTTPClient: &http.Client{Transport: chaos},
+		RetryConfig: &RetryConfig{
+			MaxRetries:   maxRetries,
+			InitialDelay: time.Millisecond,
+			MaxDelay:     5 * time.Millisecond,
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+// newHealthServer counts requests and reports healthy
+func newHealthServer(hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status": "healthy", "version": "1.0.0", "services": {"zerodb": "healthy"}}`))
+	}))
+}
+
+func TestChaosTransport_ScriptedFaultsAreRetried(t *testing.T) {
+	tests := []struct {
+		name  string
+		fault Fault
+	}{
+		{"server error", Fault{Kind: FaultServerError, Status: http.StatusBadGateway}},
+		{"rate limit", Fault{Kind: FaultRateLimit}},
+		{"connection reset", Fault{Kind: FaultReset}},
+		{"truncated body", Fault{Kind: FaultTruncate}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits int32
+			server := newHealthServer(&hits)
+			defer server.Close()
+
+			chaos := NewChaosTransport(&ChaosConfig{Script: []Fault{tt.fault, tt.fault}})
+			client := newChaosClient(t, server, chaos, 3)
+
+			health, err := client.Health(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, "healthy", health.Status)
+			assert.Equal(t, 3, chaos.Requests())
+			assert.Equal(t, 2, chaos.Injected(tt.fault.Kind))
+		})
+	}
+}
+
+func TestChaosTransport_FaultsSurfaceAsErrors(t *testing.T) {
+	var hits int32
+	server := newHealthServer(&hits)
+	defer server.Close()
+	ctx := context.Background()
+
+	chaos := NewChaosTransport(&ChaosConfig{Script: []Fault{
+		{Kind: FaultRateLimit},
+		{Kind: FaultServerError},
+		{Kind: FaultReset},
+	}})
+
+	var resp *Response
+	client := newChaosClient(t, server, chaos, 0, func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) error {
+			err := next(ctx, req)
+			resp = req.Response
+			return err
+		}
+	})
+
+	_, err := client.Health(ctx)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	require.NotNil(t, resp)
+	assert.Equal(t, "0", resp.Header.Get(HeaderRetryAfter))
+
+	_, err = client.Health(ctx)
+	assert.True(t, errors.Is(err, ErrServiceUnavailable))
+
+	_, err = client.Health(ctx)
+	assert.True(t, errors.Is(err, ErrNetwork))
+	assert.True(t, errors.Is(err, syscall.ECONNRESET))
+
+	// Requests past the end of the script pass through
+	_, err = client.Health(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestChaosTransport_Probabilities(t *testing.T) {
+	var hits int32
+	server := newHealthServer(&hits)
+	defer server.Close()
+
+	chaos := NewChaosTransport(&ChaosConfig{
+		ServerErrorProbability: 1,
+		ServerErrorBurst:       3,
+		ServerErrorStatus:      http.StatusInternalServerError,
+		Seed:                   1,
+	})
+
+	for i := 0; i < 4; i++ {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := chaos.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	}
+	assert.Equal(t, 4, chaos.Injected(FaultServerError))
+	assert.Zero(t, atomic.LoadInt32(&hits))
+
+	// Equal seeds inject the same faults
+	sequence := func() []FaultKind {
+		chaos := NewChaosTransport(&ChaosConfig{ResetProbability: 0.3, RateLimitProbability: 0.3, Seed: 42})
+		var kinds []FaultKind
+		for i := 0; i < 20; i++ {
+			kinds = append(kinds, chaos.nextFault().Kind)
+		}
+		return kinds
+	}
+	first := sequence()
+	assert.Equal(t, first, sequence())
+	assert.Contains(t, first, FaultReset)
+	assert.Contains(t, first, FaultRateLimit)
+	assert.Contains(t, first, FaultNone)
+}
+
+func TestChaosTransport_Latency(t *testing.T) {
+	var hits int32
+	server := newHealthServer(&hits)
+	defer server.Close()
+
+	chaos := NewChaosTransport(&ChaosConfig{LatencyProbability: 1, Latency: time.Hour})
+	client := newChaosClient(t, server, chaos, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Health(ctx)
+	assert.True(t, errors.Is(err, ErrTimeout))
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, 1, chaos.Delayed())
+	assert.Zero(t, atomic.LoadInt32(&hits))
+}
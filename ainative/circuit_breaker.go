@@ -0,0 +1,250 @@
+package ainative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCircuitFailureThreshold is the number of consecutive failures that opens a circuit
+	DefaultCircuitFailureThreshold = 5
+
+	// DefaultCircuitOpenTimeout is how long a circuit stays open before probing again
+	DefaultCircuitOpenTimeout = 30 * time.Second
+)
+
+// CircuitBreakerConfig configures the per-service circuit breaker
+type CircuitBreakerConfig struct {
+	// Consecutive failures before the circuit opens (defaults to 5)
+	FailureThreshold int
+
+	// Time the circuit stays open before allowing a trial request (defaults to 30s)
+	OpenTimeout time.Duration
+
+	// Concurrent trial requests allowed while half-open (defaults to 1)
+	HalfOpenMaxRequests int
+}
+
+// CircuitState represents the state of a circuit breaker
+type CircuitState string
+
+const (
+	CircuitStateClosed   CircuitState = "closed"
+	CircuitStateOpen     CircuitState = "open"
+	CircuitStateHalfOpen CircuitState = "half_open"
+)
+
+// CircuitOpenError is returned without contacting the server while the
+// circuit for a service is open
+type CircuitOpenError struct {
+	Service string
+	RetryAt time.Time
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for %s until %s", e.Service, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// circuit tracks the state of a single service
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	inFlight int
+}
+
+// circuitBreaker holds one circuit per API service prefix
+type circuitBreaker struct {
+	mu       sync.Mutex
+	config   CircuitBreakerConfig
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+// newCircuitBreaker creates a circuit breaker, filling in defaults
+func newCircuitBreaker(config CircuitBreakerConfig) *circuitBreaker {
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = DefaultCircuitFailureThreshold
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = DefaultCircuitOpenTimeout
+	}
+	if config.HalfOpenMaxRequests <= 0 {
+		config.HalfOpenMaxRequests = 1
+	}
+
+	return &circuitBreaker{
+		config:   config,
+		circuits: make(map[string]*circuit),
+		now:      time.Now,
+	}
+}
+
+// allow reports whether a request to service may proceed
+func (b *circuitBreaker) allow(service string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuitLocked(service)
+
+	switch c.state {
+	case CircuitStateOpen:
+		retryAt := c.openedAt.Add(b.config.OpenTimeout)
+		if b.now().Before(retryAt) {
+			return &CircuitOpenError{Service: service, RetryAt: retryAt}
+		}
+		c.state = CircuitStateHalfOpen
+		c.inFlight = 0
+		fallthrough
+	case CircuitStateHalfOpen:
+		if c.inFlight >= b.config.HalfOpenMaxRequests {
+			return &CircuitOpenError{Service: service, RetryAt: b.now().Add(b.config.OpenTimeout)}
+		}
+		c.inFlight++
+	}
+
+	return nil
+}
+
+// requestOutcome is the result of a request as seen by the circuit breaker
+type requestOutcome int
+
+const (
+	outcomeSuccess requestOutcome = iota
+	outcomeFailure
+	// The caller gave up before the service answered, which says nothing
+	// about its health
+	outcomeCancelled
+)
+
+// record updates the circuit for service with the outcome of a request
+func (b *circuitBreaker) record(service string, outcome requestOutcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuitLocked(service)
+
+	if c.state == CircuitStateHalfOpen && c.inFlight > 0 {
+		c.inFlight--
+	}
+
+	switch outcome {
+	case outcomeCancelled:
+		// Free the half-open slot for another trial request
+		return
+	case outcomeSuccess:
+		switch c.state {
+		case CircuitStateHalfOpen:
+			c.state = CircuitStateClosed
+			c.failures = 0
+		case CircuitStateClosed:
+			c.failures = 0
+		}
+		// A success while open comes from a request admitted before the
+		// circuit opened and says nothing about recovery
+		return
+	}
+
+	c.failures++
+	if c.state == CircuitStateHalfOpen || c.failures >= b.config.FailureThreshold {
+		c.state = CircuitStateOpen
+		c.openedAt = b.now()
+	}
+}
+
+// states returns a snapshot of every known circuit
+func (b *circuitBreaker) states() map[string]CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	states := make(map[string]CircuitState, len(b.circuits))
+	for service, c := range b.circuits {
+		state := c.state
+		if state == CircuitStateOpen && !b.now().Before(c.openedAt.Add(b.config.OpenTimeout)) {
+			state = CircuitStateHalfOpen
+		}
+		states[service] = state
+	}
+	return states
+}
+
+// circuitLocked returns the circuit for service, creating it if needed
+func (b *circuitBreaker) circuitLocked(service string) *circuit {
+	c, ok := b.circuits[service]
+	if !ok {
+		c = &circuit{state: CircuitStateClosed}
+		b.circuits[service] = c
+	}
+	return c
+}
+
+// middleware fails fast while a service's circuit is open
+func (b *circuitBreaker) middleware(next RequestHandler) RequestHandler {
+	return func(ctx context.Context, req *Request) error {
+		service := servicePrefix(req.Path)
+
+		if err := b.allow(service); err != nil {
+			return err
+		}
+
+		err := next(ctx, req)
+		b.record(service, circuitOutcome(ctx, err))
+		return err
+	}
+}
+
+// circuitOutcome classifies the result of a request. Server errors and
+// network failures are failures, client errors are successes, and errors
+// after the caller's ctx ended are cancellations.
+func circuitOutcome(ctx context.Context, err error) requestOutcome {
+	if err == nil {
+		return outcomeSuccess
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.IsServerError() {
+			return outcomeFailure
+		}
+		return outcomeSuccess
+	}
+
+	if ctx.Err() != nil {
+		return outcomeCancelled
+	}
+	return outcomeFailure
+}
+
+// servicePrefix maps a request path to its API service, e.g.
+// "/api/v1/zerodb/projects/123" becomes "/api/v1/zerodb"
+func servicePrefix(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 4)
+	if len(segments) >= 3 && segments[0] == "api" {
+		return "/" + strings.Join(segments[:3], "/")
+	}
+	return "/" + segments[0]
+}
+
+// CircuitBreakerStates returns the circuit state of every API service the
+// client has called, keyed by service prefix (e.g. "/api/v1/embeddings").
+// It returns nil when no circuit breaker is configured.
+func (c *Client) CircuitBreakerStates() map[string]CircuitState {
+	if c.breaker == nil {
+		return nil
+	}
+	return c.breaker.states()
+}
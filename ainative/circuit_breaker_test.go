@@ -0,0 +1,219 @@
+package ainative
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePrefix(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/embeddings/generate":            "/api/v1/embeddings",
+		"/api/v1/zerodb/projects/123/vectors":    "/api/v1/zerodb",
+		"/api/v1/agent-swarm/swarms?limit=10":    "/api/v1/agent-swarm",
+		"/api/v1/memory":                         "/api/v1/memory",
+		"/health":                                "/health",
+		"/api/v1/agent-state/state?agent_id=a/b": "/api/v1/agent-state",
+	}
+
+	for path, want := range tests {
+		assert.Equal(t, want, servicePrefix(path), path)
+	}
+}
+
+func TestCircuitBreaker_StateTransitions(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute})
+	breaker.now = func() time.Time { return now }
+
+	service := "/api/v1/embeddings"
+
+	require.NoError(t, breaker.allow(service))
+	breaker.record(service, outcomeFailure)
+	assert.Equal(t, CircuitStateClosed, breaker.states()[service])
+
+	require.NoError(t, breaker.allow(service))
+	breaker.record(service, outcomeFailure)
+	assert.Equal(t, CircuitStateOpen, breaker.states()[service])
+
+	err := breaker.allow(service)
+	var openErr *CircuitOpenError
+	require.True(t, errors.As(err, &openErr))
+	assert.Equal(t, service, openErr.Service)
+	assert.Equal(t, now.Add(time.Minute), openErr.RetryAt)
+
+	// After the timeout a single trial request is let through
+	now = now.Add(time.Minute)
+	assert.Equal(t, CircuitStateHalfOpen, breaker.states()[service])
+	require.NoError(t, breaker.allow(service))
+	assert.Error(t, breaker.allow(service))
+
+	// A failed trial reopens the circuit
+	breaker.record(service, outcomeFailure)
+	assert.Equal(t, CircuitStateOpen, breaker.states()[service])
+
+	// A successful trial closes it
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.allow(service))
+	breaker.record(service, outcomeSuccess)
+	assert.Equal(t, CircuitStateClosed, breaker.states()[service])
+}
+
+func TestCircuitBreaker_IgnoresLateSuccessWhileOpen(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute})
+	breaker.now = func() time.Time { return now }
+
+	service := "/api/v1/embeddings"
+
+	// Two requests are admitted while closed; the first failure opens the
+	// circuit and the slower request then succeeds
+	require.NoError(t, breaker.allow(service))
+	require.NoError(t, breaker.allow(service))
+	breaker.record(service, outcomeFailure)
+	breaker.record(service, outcomeSuccess)
+
+	assert.Equal(t, CircuitStateOpen, breaker.states()[service])
+	assert.Error(t, breaker.allow(service))
+}
+
+func TestCircuitBreaker_CancelledProbe(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenMaxRequests: 1})
+	breaker.now = func() time.Time { return now }
+
+	service := "/api/v1/embeddings"
+
+	require.NoError(t, breaker.allow(service))
+	breaker.record(service, outcomeFailure)
+	now = now.Add(time.Minute)
+
+	// A cancelled trial neither closes nor reopens the circuit, and frees
+	// the slot for another trial
+	require.NoError(t, breaker.allow(service))
+	breaker.record(service, outcomeCancelled)
+	assert.Equal(t, CircuitStateHalfOpen, breaker.states()[service])
+	require.NoError(t, breaker.allow(service))
+	breaker.record(service, outcomeSuccess)
+	assert.Equal(t, CircuitStateClosed, breaker.states()[service])
+}
+
+func TestClientCircuitBreaker_CancelledProbeKeepsCircuitHalfOpen(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		time.Sleep(200 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status": "healthy"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:         "test-key",
+		BaseURL:        server.URL,
+		RetryConfig:    &RetryConfig{MaxRetries: 0},
+		CircuitBreaker: &CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: 50 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Embeddings.HealthCheck(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, CircuitStateOpen, client.CircuitBreakerStates()["/api/v1/embeddings"])
+
+	time.Sleep(60 * time.Millisecond)
+	failing.Store(false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.ZeroDB.Embeddings.HealthCheck(ctx)
+	require.Error(t, err)
+	assert.Equal(t, CircuitStateHalfOpen, client.CircuitBreakerStates()["/api/v1/embeddings"])
+}
+
+func TestClientCircuitBreaker_FailsFastPerService(t *testing.T) {
+	embeddingHits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/embeddings/health" {
+			embeddingHits++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message": "embedding backend down"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "healthy"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:         "test-key",
+		BaseURL:        server.URL,
+		RetryConfig:    &RetryConfig{MaxRetries: 0},
+		CircuitBreaker: &CircuitBreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err = client.ZeroDB.Embeddings.HealthCheck(ctx)
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+	}
+
+	_, err = client.ZeroDB.Embeddings.HealthCheck(ctx)
+	var openErr *CircuitOpenError
+	require.True(t, errors.As(err, &openErr))
+	assert.Equal(t, "/api/v1/embeddings", openErr.Service)
+	assert.Equal(t, 2, embeddingHits)
+
+	// Other services are unaffected
+	health, err := client.Health(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "healthy", health.Status)
+
+	states := client.CircuitBreakerStates()
+	assert.Equal(t, CircuitStateOpen, states["/api/v1/embeddings"])
+	assert.Equal(t, CircuitStateClosed, states["/health"])
+}
+
+func TestClientCircuitBreaker_IgnoresClientErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "not found"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:         "test-key",
+		BaseURL:        server.URL,
+		CircuitBreaker: &CircuitBreakerConfig{FailureThreshold: 1},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = client.AgentSwarm.Get(context.Background(), "swarm-1")
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+	}
+
+	assert.Equal(t, CircuitStateClosed, client.CircuitBreakerStates()["/api/v1/agent-swarm"])
+}
+
+func TestClientCircuitBreaker_Disabled(t *testing.T) {
+	client, err := NewClient(&Config{APIKey: "test-key"})
+	require.NoError(t, err)
+
+	assert.Nil(t, client.CircuitBreakerStates())
+}
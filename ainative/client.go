@@ -2,16 +2,19 @@ package ainative
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
-	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-resty/resty/v2"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
-	"golang.org/x/time/rate"
 )
 
 const (
@@ -39,12 +42,28 @@ type Client struct {
 	// Configuration
 	config *Config
 	
-	// Rate limiter
-	rateLimiter *rate.Limiter
+	// Guards the scope fields of config against the deprecated setters
+	scopeMu *sync.RWMutex
+	
+	// Rate limiter, adapted from the server's rate limit headers
+	rateLimiter *adaptiveLimiter
 	
 	// OpenTelemetry tracer
 	tracer trace.Tracer
 	
+	// Request handler with middleware applied
+	handler RequestHandler
+	
+	// Per-service circuit breaker (nil when disabled)
+	breaker *circuitBreaker
+	
+	// Structured logger (nil when disabled)
+	logger *slog.Logger
+	
+	// OpenTelemetry trace context propagator and metric instruments
+	propagator propagation.TextMapPropagator
+	metrics    *clientMetrics
+	
 	// API service clients
 	ZeroDB              *ZeroDBService
 	AgentSwarm          *AgentSwarmService
@@ -57,12 +76,18 @@ type Client struct {
 
 // Config holds the configuration for the AINative client
 type Config struct {
-	// Required: API key for authentication
+	// Required unless TokenSource is set: API key for authentication
 	APIKey string
 	
-	// Optional: API secret for enhanced security
+	// Optional: API secret. By default it is sent with the API key as
+	// "Bearer key:secret"; with SignRequests it signs requests instead.
 	APISecret string
 	
+	// Optional: Sign requests with APISecret (see SignRequest) instead of
+	// sending it in the Authorization header. The server must support
+	// signed requests.
+	SignRequests bool
+	
 	// Optional: Base URL for the API (defaults to production)
 	BaseURL string
 	
@@ -87,8 +112,32 @@ type Config struct {
 	// Optional: OpenTelemetry tracer
 	Tracer trace.Tracer
 	
-	// Optional: Debug mode
+	// Optional: OpenTelemetry meter provider (defaults to the global provider)
+	MeterProvider metric.MeterProvider
+	
+	// Optional: Trace context propagator (defaults to W3C trace context and baggage)
+	Propagator propagation.TextMapPropagator
+	
+	// Optional: Debug mode (dumps raw requests, including the Authorization
+	// header, to stdout; prefer Logger)
 	Debug bool
+	
+	// Optional: Middleware applied to every request, outermost first
+	Middleware []Middleware
+	
+	// Optional: Source of bearer tokens, used instead of APIKey
+	TokenSource TokenSource
+	
+	// Optional: Per-service circuit breaker (disabled when nil)
+	CircuitBreaker *CircuitBreakerConfig
+	
+	// Optional: Structured logger for request, retry and rate limit events.
+	// Secrets are redacted; bodies are only logged at debug level.
+	Logger *slog.Logger
+	
+	// Optional: Wire encoding of vectors in upserts, searches and embedding
+	// responses (defaults to VectorEncodingJSON)
+	VectorEncoding VectorEncoding
 }
 
 // RetryConfig configures retry behavior
@@ -109,14 +158,27 @@ type RetryConfig struct {
 	Jitter bool
 }
 
-// NewClient creates a new AINative API client
+// defaultRetryConfig returns the retry configuration used when none is set
+func defaultRetryConfig() *RetryConfig {
+	return &RetryConfig{
+		MaxRetries:        DefaultMaxRetries,
+		InitialDelay:      100 * time.Millisecond,
+		MaxDelay:          10 * time.Second,
+		BackoffMultiplier: 2.0,
+		Jitter:           true,
+	}
+}
+
+// NewClient creates a new AINative API client. The configuration is checked
+// with Config.Validate; use LoadConfig to build one from the environment and
+// config file.
 func NewClient(config *Config) (*Client, error) {
 	if config == nil {
 		return nil, fmt.Errorf("config cannot be nil")
 	}
 	
-	if config.APIKey == "" {
-		return nil, fmt.Errorf("API key is required")
+	if err := config.Validate(); err != nil {
+		return nil, err
 	}
 	
 	// Set defaults
@@ -132,12 +194,6 @@ func NewClient(config *Config) (*Client, error) {
 		config.RateLimit = DefaultRateLimit
 	}
 	
-	// Validate base URL
-	_, err := url.Parse(config.BaseURL)
-	if err != nil {
-		return nil, fmt.Errorf("invalid base URL: %w", err)
-	}
-	
 	// Create HTTP client
 	var baseHTTPClient *http.Client
 	if config.HTTPClient != nil {
@@ -164,29 +220,29 @@ func NewClient(config *Config) (*Client, error) {
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json")
 	
-	// Set authentication header
-	if config.APISecret != "" {
-		// Use API key + secret for enhanced authentication
-		httpClient.SetHeader("Authorization", fmt.Sprintf("Bearer %s:%s", config.APIKey, config.APISecret))
-	} else {
-		httpClient.SetHeader("Authorization", fmt.Sprintf("Bearer %s", config.APIKey))
+	// Set authentication header (a token source authorizes each request instead)
+	if config.TokenSource == nil {
+		if config.APISecret != "" && !config.SignRequests {
+			// Use API key + secret for enhanced authentication
+			httpClient.SetHeader("Authorization", fmt.Sprintf("Bearer %s:%s", config.APIKey, config.APISecret))
+		} else {
+			httpClient.SetHeader("Authorization", fmt.Sprintf("Bearer %s", config.APIKey))
+		}
 	}
 	
-	// Set organization header if provided
-	if config.OrganizationID != "" {
-		httpClient.SetHeader("X-Organization-ID", config.OrganizationID)
+	// Sign each attempt with the API secret, so retries carry a fresh
+	// timestamp and nonce and the secret never leaves the process
+	if config.SignRequests {
+		secret := config.APISecret
+		httpClient.SetPreRequestHook(func(_ *resty.Client, req *http.Request) error {
+			return SignRequest(req, secret)
+		})
 	}
 	
 	// Configure retry
 	retryConfig := config.RetryConfig
 	if retryConfig == nil {
-		retryConfig = &RetryConfig{
-			MaxRetries:        DefaultMaxRetries,
-			InitialDelay:      100 * time.Millisecond,
-			MaxDelay:          10 * time.Second,
-			BackoffMultiplier: 2.0,
-			Jitter:           true,
-		}
+		retryConfig = defaultRetryConfig()
 	}
 	
 	httpClient.
@@ -201,142 +257,278 @@ func NewClient(config *Config) (*Client, error) {
 			
 			// Retry on 5xx errors and 429 (rate limit)
 			return r.StatusCode() >= 500 || r.StatusCode() == 429
-		})
+		}).
+		SetRetryAfter(retryAfter)
+	
+	if config.Logger != nil {
+		httpClient.SetLogger(restyLogger{logger: config.Logger})
+		httpClient.AddRetryHook(retryLogHook(config.Logger))
+	}
 	
-	// Enable debug mode if requested
+	// Enable debug mode if requested, keeping secrets out of the dump
 	if config.Debug {
-		httpClient.SetDebug(true)
+		httpClient.SetDebug(true).
+			OnRequestLog(redactRequestLog).
+			OnResponseLog(redactResponseLog)
 	}
 	
-	// Create rate limiter
-	rateLimiter := rate.NewLimiter(rate.Limit(config.RateLimit), config.RateLimit)
+	// Create rate limiter and keep it in step with the server's quota
+	rateLimiter := newAdaptiveLimiter(config.RateLimit)
+	httpClient.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+		rateLimiter.Observe(r.StatusCode(), r.Header())
+		return nil
+	})
 	
 	// Set up tracer
 	tracer := config.Tracer
 	if tracer == nil {
-		tracer = otel.Tracer("ainative-go-sdk")
+		tracer = otel.Tracer(instrumentationName)
+	}
+	
+	propagator := config.Propagator
+	if propagator == nil {
+		propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	}
+	
+	metrics, err := newClientMetrics(config.MeterProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metric instruments: %w", err)
 	}
 	
 	// Create client
 	client := &Client{
 		httpClient:  httpClient,
 		config:      config,
+		scopeMu:     &sync.RWMutex{},
 		rateLimiter: rateLimiter,
 		tracer:      tracer,
+		logger:      config.Logger,
+		propagator:  propagator,
+		metrics:     metrics,
+	}
+	
+	middleware := append([]Middleware{}, config.Middleware...)
+	if config.Logger != nil {
+		middleware = append(middleware, loggingMiddleware(config.Logger))
+	}
+	if config.CircuitBreaker != nil {
+		client.breaker = newCircuitBreaker(*config.CircuitBreaker)
+		middleware = append(middleware, client.breaker.middleware)
+	}
+	if config.TokenSource != nil {
+		middleware = append(middleware, tokenSourceMiddleware(config.TokenSource))
 	}
+	client.handler = chainMiddleware(client.doRequest, middleware...)
 	
-	// Initialize service clients
-	client.ZeroDB = NewZeroDBService(client)
-	client.AgentSwarm = NewAgentSwarmService(client)
-	client.AgentOrchestration = NewAgentOrchestrationService(client)
-	client.AgentCoordination = NewAgentCoordinationService(client)
-	client.AgentLearning = NewAgentLearningService(client)
-	client.AgentState = NewAgentStateService(client)
-	client.Auth = NewAuthService(client)
+	client.initServices()
+	
+	if binder, ok := config.TokenSource.(clientBinder); ok {
+		binder.bindClient(client)
+	}
 
 	return client, nil
 }
 
-// makeRequest performs an HTTP request with rate limiting and tracing
-func (c *Client) makeRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+// initServices creates the API service clients bound to c
+func (c *Client) initServices() {
+	c.ZeroDB = NewZeroDBService(c)
+	c.AgentSwarm = NewAgentSwarmService(c)
+	c.AgentOrchestration = NewAgentOrchestrationService(c)
+	c.AgentCoordination = NewAgentCoordinationService(c)
+	c.AgentLearning = NewAgentLearningService(c)
+	c.AgentState = NewAgentStateService(c)
+	c.Auth = NewAuthService(c)
+}
+
+// makeRequest performs an HTTP request through the middleware chain with
+// tracing and metrics
+func (c *Client) makeRequest(ctx context.Context, method string, path *requestPath, body interface{}, result interface{}) error {
+	req := &Request{
+		Method: strings.ToUpper(method),
+		Path:   path.String(),
+		Header: make(http.Header),
+		Body:   body,
+		Result: result,
+	}
+	
+	// Mutating requests carry a key that stays the same across retries
+	if req.Method != "GET" {
+		key, err := idempotencyKey(ctx)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(HeaderIdempotencyKey, key)
+	}
+	
 	// Start tracing span
-	ctx, span := c.tracer.Start(ctx, fmt.Sprintf("ainative.%s %s", method, path))
+	route := path.Route()
+	ctx = withRoute(ctx, route)
+	ctx, span := c.startSpan(ctx, req, route)
 	defer span.End()
 	
+	// Scope headers come from this client's config, so derived clients
+	// never affect each other
+	projectID, orgID := c.scope()
+	if orgID != "" {
+		req.Header.Set(HeaderOrganizationID, orgID)
+	}
+	if projectID != "" {
+		req.Header.Set(HeaderProjectID, projectID)
+	}
+	if c.compactVectors() {
+		req.Header.Set(HeaderVectorEncoding, string(VectorEncodingBase64))
+	}
+	
+	start := time.Now()
+	err := c.handler(ctx, req)
+	latency := time.Since(start)
+	
+	c.finishTelemetry(ctx, span, req, route, latency, err)
+	
+	if meta := responseMetaFromContext(ctx); meta != nil {
+		meta.fill(req.Response, latency)
+	}
+	
+	return err
+}
+
+// doRequest performs an HTTP request with rate limiting. It is the innermost
+// handler of the middleware chain.
+func (c *Client) doRequest(ctx context.Context, r *Request) error {
 	// Apply rate limiting
+	waitStart := time.Now()
 	if err := c.rateLimiter.Wait(ctx); err != nil {
-		return fmt.Errorf("rate limit wait failed: %w", err)
+		// The limiter fails early when the wait would outlast the deadline
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		} else {
+			err = fmt.Errorf("%w: %v", context.DeadlineExceeded, err)
+		}
+		return NewNetworkError("rate limit wait failed", err)
 	}
+	c.recordRateLimitWait(ctx, r, time.Since(waitStart))
 	
 	// Create request
 	req := c.httpClient.R().SetContext(ctx)
 	
+	for key, values := range r.Header {
+		req.Header[key] = values
+	}
+	
 	// Set body if provided
-	if body != nil {
-		req.SetBody(body)
+	if r.Body != nil {
+		req.SetBody(r.Body)
 	}
 	
 	// Set result if provided
-	if result != nil {
-		req.SetResult(result)
+	if r.Result != nil {
+		req.SetResult(r.Result)
 	}
 	
-	// Set error response handler
-	var apiError APIError
-	req.SetError(&apiError)
-	
 	// Make request
 	var resp *resty.Response
 	var err error
 	
-	switch strings.ToUpper(method) {
+	switch strings.ToUpper(r.Method) {
 	case "GET":
-		resp, err = req.Get(path)
+		resp, err = req.Get(r.Path)
 	case "POST":
-		resp, err = req.Post(path)
+		resp, err = req.Post(r.Path)
 	case "PUT":
-		resp, err = req.Put(path)
+		resp, err = req.Put(r.Path)
 	case "DELETE":
-		resp, err = req.Delete(path)
+		resp, err = req.Delete(r.Path)
 	case "PATCH":
-		resp, err = req.Patch(path)
+		resp, err = req.Patch(r.Path)
 	default:
-		return fmt.Errorf("unsupported HTTP method: %s", method)
+		return fmt.Errorf("unsupported HTTP method: %s", r.Method)
 	}
 	
-	// Handle network errors
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+	// Handle network errors. Retries stopped by retryAfter still carry the
+	// server's response, which is decoded below.
+	if err != nil && !(errors.Is(err, errRetryWaitTooLong) && resp != nil && resp.RawResponse != nil) {
+		return NewNetworkError("request failed", err)
+	}
+	
+	r.Response = &Response{
+		StatusCode: resp.StatusCode(),
+		Header:     resp.Header(),
+		Attempts:   resp.Request.Attempt,
 	}
 	
 	// Handle API errors
 	if resp.StatusCode() >= 400 {
-		if apiError.Message != "" {
-			apiError.StatusCode = resp.StatusCode()
-			return &apiError
-		}
-		
-		// Fallback error
-		return &APIError{
-			StatusCode: resp.StatusCode(),
-			Message:    fmt.Sprintf("API request failed with status %d", resp.StatusCode()),
-			RequestID:  resp.Header().Get("X-Request-ID"),
-		}
+		return decodeAPIError(resp.StatusCode(), resp.Header(), resp.Body())
 	}
 	
 	return nil
 }
 
+// errRetryWaitTooLong stops resty's retries when the server asks for a longer
+// wait than RetryConfig.MaxDelay allows
+var errRetryWaitTooLong = errors.New("server requested retry wait exceeds max retry delay")
+
+// retryAfter tells resty how long to wait before retrying a response. It
+// honors Retry-After and, for 429s without one, the rate limit reset time.
+// Returning zero falls back to exponential backoff. A wait longer than
+// RetryConfig.MaxDelay stops the retries, so the response is returned
+// instead of being retried too early.
+func retryAfter(client *resty.Client, r *resty.Response) (time.Duration, error) {
+	if r == nil {
+		return 0, nil
+	}
+	
+	now := time.Now()
+	wait, ok := parseRetryAfter(r.Header(), now)
+	if !ok && r.StatusCode() == http.StatusTooManyRequests && r.Header().Get(HeaderRateLimitRemaining) == "0" {
+		if resetAt, reset := parseRateLimitReset(r.Header(), now); reset && resetAt.After(now) {
+			wait, ok = resetAt.Sub(now), true
+		}
+	}
+	if !ok {
+		return 0, nil
+	}
+	
+	if client != nil && client.RetryMaxWaitTime > 0 && wait > client.RetryMaxWaitTime {
+		return 0, errRetryWaitTooLong
+	}
+	return wait, nil
+}
+
 // GetConfig returns the client configuration
 func (c *Client) GetConfig() *Config {
 	return c.config
 }
 
-// SetProjectID sets the default project ID for operations
+// SetProjectID sets the default project ID for operations.
+//
+// Deprecated: SetProjectID mutates the client, so requests already in flight
+// may be sent with either project. Clients derived with WithProject or
+// WithOrganization are not affected. Use WithProject to derive a
+// project-scoped client instead.
 func (c *Client) SetProjectID(projectID string) {
+	c.scopeMu.Lock()
+	defer c.scopeMu.Unlock()
 	c.config.ProjectID = projectID
-	if projectID != "" {
-		c.httpClient.SetHeader("X-Project-ID", projectID)
-	} else {
-		c.httpClient.SetHeader("X-Project-ID", "")
-	}
 }
 
-// SetOrganizationID sets the default organization ID for operations  
+// SetOrganizationID sets the default organization ID for operations.
+//
+// Deprecated: SetOrganizationID mutates the client, so requests already in
+// flight may be sent with either organization. Clients derived with
+// WithProject or WithOrganization are not affected. Use WithOrganization to
+// derive an organization-scoped client instead.
 func (c *Client) SetOrganizationID(orgID string) {
+	c.scopeMu.Lock()
+	defer c.scopeMu.Unlock()
 	c.config.OrganizationID = orgID
-	if orgID != "" {
-		c.httpClient.SetHeader("X-Organization-ID", orgID)
-	} else {
-		c.httpClient.SetHeader("X-Organization-ID", "")
-	}
 }
 
 // Health checks the API health
 func (c *Client) Health(ctx context.Context) (*HealthResponse, error) {
 	var result HealthResponse
 	
-	err := c.makeRequest(ctx, "GET", "/health", nil, &result)
+	err := c.makeRequest(ctx, "GET", endpoint("/health"), nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +163,7 @@ func TestClientMakeRequest(t *testing.T) {
 	// Test GET request
 	ctx := context.Background()
 	var result map[string]interface{}
-	err = client.makeRequest(ctx, "GET", "/test", nil, &result)
+	err = client.makeRequest(ctx, "GET", endpoint("/test"), nil, &result)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "success", result["message"])
@@ -188,7 +188,7 @@ func TestClientMakeRequestWithError(t *testing.T) {
 
 	ctx := context.Background()
 	var result map[string]interface{}
-	err = client.makeRequest(ctx, "GET", "/test", nil, &result)
+	err = client.makeRequest(ctx, "GET", endpoint("/test"), nil, &result)
 
 	assert.Error(t, err)
 	apiErr, ok := err.(*APIError)
@@ -267,7 +267,7 @@ func TestClientRetryConfiguration(t *testing.T) {
 
 	ctx := context.Background()
 	var result map[string]interface{}
-	err = client.makeRequest(ctx, "GET", "/test", nil, &result)
+	err = client.makeRequest(ctx, "GET", endpoint("/test"), nil, &result)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "success", result["status"])
@@ -294,7 +294,7 @@ func TestClientRateLimit(t *testing.T) {
 
 	// First request should be immediate
 	start := time.Now()
-	err = client.makeRequest(ctx, "GET", "/test1", nil, &result)
+	err = client.makeRequest(ctx, "GET", endpoint("/test1"), nil, &result)
 	duration1 := time.Since(start)
 
 	assert.NoError(t, err)
@@ -302,7 +302,7 @@ func TestClientRateLimit(t *testing.T) {
 
 	// Second request should be rate limited
 	start = time.Now()
-	err = client.makeRequest(ctx, "GET", "/test2", nil, &result)
+	err = client.makeRequest(ctx, "GET", endpoint("/test2"), nil, &result)
 	duration2 := time.Since(start)
 
 	assert.NoError(t, err)
@@ -315,7 +315,7 @@ func TestClientUnsupportedMethod(t *testing.T) {
 	require.NoError(t, err)
 
 	ctx := context.Background()
-	err = client.makeRequest(ctx, "UNSUPPORTED", "/test", nil, nil)
+	err = client.makeRequest(ctx, "UNSUPPORTED", endpoint("/test"), nil, nil)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "unsupported HTTP method")
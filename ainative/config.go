@@ -0,0 +1,444 @@
+package ainative
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by LoadConfig
+const (
+	EnvAPIKey         = "AINATIVE_API_KEY"
+	EnvAPISecret      = "AINATIVE_API_SECRET"
+	EnvBaseURL        = "AINATIVE_BASE_URL"
+	EnvOrganizationID = "AINATIVE_ORG_ID"
+	EnvProjectID      = "AINATIVE_PROJECT_ID"
+	EnvTimeout        = "AINATIVE_TIMEOUT"
+	EnvRateLimit      = "AINATIVE_RATE_LIMIT"
+	EnvMaxRetries     = "AINATIVE_MAX_RETRIES"
+	EnvDebug          = "AINATIVE_DEBUG"
+	EnvSignRequests   = "AINATIVE_SIGN_REQUESTS"
+	EnvVectorEncoding = "AINATIVE_VECTOR_ENCODING"
+	EnvProfile        = "AINATIVE_PROFILE"
+	EnvConfigFile     = "AINATIVE_CONFIG"
+)
+
+// DefaultProfile is the profile loaded when none is selected
+const DefaultProfile = "default"
+
+// ConfigErrors is a list of configuration errors returned by Config.Validate.
+// errors.As finds each *ConfigError and errors.Is matches ErrConfig.
+type ConfigErrors []*ConfigError
+
+// Error implements the error interface
+func (e ConfigErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the individual configuration errors
+func (e ConfigErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Validate checks the configuration, returning ConfigErrors describing every
+// invalid field. Zero values are valid where NewClient applies a default.
+func (c *Config) Validate() error {
+	var errs ConfigErrors
+
+	if c.APIKey == "" && c.TokenSource == nil {
+		errs = append(errs, NewConfigError("api_key", "API key is required"))
+	}
+
+	if c.BaseURL != "" {
+		if u, err := url.Parse(c.BaseURL); err != nil {
+			errs = append(errs, NewConfigError("base_url", fmt.Sprintf("invalid base URL: %v", err)))
+		} else if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			errs = append(errs, NewConfigError("base_url", fmt.Sprintf("invalid base URL %q: must be an absolute http or https URL", c.BaseURL)))
+		}
+	}
+
+	if c.SignRequests && c.APISecret == "" {
+		errs = append(errs, NewConfigError("api_secret", "API secret is required to sign requests"))
+	}
+
+	if c.Timeout < 0 {
+		errs = append(errs, NewConfigError("timeout", "must not be negative"))
+	}
+	if c.RateLimit < 0 {
+		errs = append(errs, NewConfigError("rate_limit", "must not be negative"))
+	}
+
+	switch c.VectorEncoding {
+	case "", VectorEncodingJSON, VectorEncodingBase64:
+	default:
+		errs = append(errs, NewConfigError("vector_encoding", fmt.Sprintf("unknown vector encoding %q", c.VectorEncoding)))
+	}
+
+	if retry := c.RetryConfig; retry != nil {
+		if retry.MaxRetries < 0 {
+			errs = append(errs, NewConfigError("retry_config.max_retries", "must not be negative"))
+		}
+		if retry.InitialDelay < 0 {
+			errs = append(errs, NewConfigError("retry_config.initial_delay", "must not be negative"))
+		}
+		if retry.MaxDelay < 0 {
+			errs = append(errs, NewConfigError("retry_config.max_delay", "must not be negative"))
+		}
+		if retry.BackoffMultiplier < 0 {
+			errs = append(errs, NewConfigError("retry_config.backoff_multiplier", "must not be negative"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// configFile is the format of the config file
+type configFile struct {
+	DefaultProfile string                   `yaml:"default_profile"`
+	Profiles       map[string]configProfile `yaml:"profiles"`
+}
+
+// configProfile is a named set of settings in the config file
+type configProfile struct {
+	APIKey         string `yaml:"api_key"`
+	APISecret      string `yaml:"api_secret"`
+	BaseURL        string `yaml:"base_url"`
+	OrganizationID string `yaml:"organization_id"`
+	ProjectID      string `yaml:"project_id"`
+	Timeout        string `yaml:"timeout"`
+	RateLimit      *int   `yaml:"rate_limit"`
+	MaxRetries     *int   `yaml:"max_retries"`
+	Debug          *bool  `yaml:"debug"`
+	SignRequests   *bool  `yaml:"sign_requests"`
+	VectorEncoding string `yaml:"vector_encoding"`
+}
+
+// loadOptions holds the options passed to LoadConfig
+type loadOptions struct {
+	profile   string
+	path      string
+	overrides *Config
+}
+
+// LoadOption customizes LoadConfig
+type LoadOption func(*loadOptions)
+
+// WithProfile selects the config file profile, taking precedence over
+// AINATIVE_PROFILE and the file's default_profile
+func WithProfile(name string) LoadOption {
+	return func(o *loadOptions) {
+		o.profile = name
+	}
+}
+
+// WithConfigFile reads the config file at path instead of AINATIVE_CONFIG or
+// the default location
+func WithConfigFile(path string) LoadOption {
+	return func(o *loadOptions) {
+		o.path = path
+	}
+}
+
+// WithOverrides applies the non-zero fields of config after every other
+// source, e.g. values from command-line flags
+func WithOverrides(config *Config) LoadOption {
+	return func(o *loadOptions) {
+		o.overrides = config
+	}
+}
+
+// DefaultConfigPath returns the default config file location,
+// $XDG_CONFIG_HOME/ainative/config.yaml or ~/.config/ainative/config.yaml
+func DefaultConfigPath() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "ainative", "config.yaml"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "ainative", "config.yaml"), nil
+}
+
+// LoadConfig builds a Config from, in increasing order of precedence, the
+// SDK defaults, a profile in the config file, AINATIVE_* environment
+// variables and explicit overrides, then validates it.
+//
+// The config file is YAML with named profiles:
+//
+//	default_profile: prod
+//	profiles:
+//	  prod:
+//	    api_key: ak_live_...
+//	  staging:
+//	    api_key: ak_test_...
+//	    base_url: https://staging.api.ainative.studio
+//	  local:
+//	    api_key: local
+//	    base_url: http://localhost:8000
+//	    timeout: 5s
+//	    max_retries: 0
+//
+// A missing file at the default location is ignored; a file named with
+// WithConfigFile or AINATIVE_CONFIG must exist. If only validation fails,
+// the loaded config is returned along with the ConfigErrors so that tools can
+// show what was loaded.
+//
+// Example:
+//
+//	config, err := ainative.LoadConfig(ainative.WithProfile("staging"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client, err := ainative.NewClient(config)
+func LoadConfig(opts ...LoadOption) (*Config, error) {
+	var options loadOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	config := &Config{
+		BaseURL:   DefaultBaseURL,
+		Timeout:   DefaultTimeout,
+		RateLimit: DefaultRateLimit,
+	}
+
+	if err := applyConfigFile(config, options); err != nil {
+		return nil, err
+	}
+	if err := applyEnv(config); err != nil {
+		return nil, err
+	}
+	if options.overrides != nil {
+		mergeConfig(config, options.overrides)
+	}
+
+	if err := config.Validate(); err != nil {
+		return config, err
+	}
+	return config, nil
+}
+
+// applyConfigFile applies the selected profile of the config file to config
+func applyConfigFile(config *Config, options loadOptions) error {
+	path, required := options.path, options.path != ""
+	if path == "" {
+		path, required = os.Getenv(EnvConfigFile), os.Getenv(EnvConfigFile) != ""
+	}
+	if path == "" {
+		var err error
+		if path, err = DefaultConfigPath(); err != nil {
+			return nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !required && errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return NewConfigError("config_file", fmt.Sprintf("failed to read %s: %v", path, err))
+	}
+
+	var file configFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return NewConfigError("config_file", fmt.Sprintf("failed to parse %s: %v", path, err))
+	}
+
+	name := options.profile
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	if name == "" {
+		name = file.DefaultProfile
+	}
+	explicit := name != ""
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	profile, ok := file.Profiles[name]
+	if !ok {
+		if explicit {
+			return NewConfigError("profile", fmt.Sprintf("profile %q not found in %s", name, path))
+		}
+		return nil
+	}
+
+	return profile.apply(config)
+}
+
+// apply copies the settings of p into config
+func (p configProfile) apply(config *Config) error {
+	setString(&config.APIKey, p.APIKey)
+	setString(&config.APISecret, p.APISecret)
+	setString(&config.BaseURL, p.BaseURL)
+	setString(&config.OrganizationID, p.OrganizationID)
+	setString(&config.ProjectID, p.ProjectID)
+
+	if p.VectorEncoding != "" {
+		config.VectorEncoding = VectorEncoding(p.VectorEncoding)
+	}
+	if p.Timeout != "" {
+		timeout, err := parseTimeout(p.Timeout)
+		if err != nil {
+			return NewConfigError("timeout", err.Error())
+		}
+		config.Timeout = timeout
+	}
+	if p.RateLimit != nil {
+		config.RateLimit = *p.RateLimit
+	}
+	if p.MaxRetries != nil {
+		config.RetryConfig = defaultRetryConfig()
+		config.RetryConfig.MaxRetries = *p.MaxRetries
+	}
+	if p.Debug != nil {
+		config.Debug = *p.Debug
+	}
+	if p.SignRequests != nil {
+		config.SignRequests = *p.SignRequests
+	}
+	return nil
+}
+
+// applyEnv applies AINATIVE_* environment variables to config
+func applyEnv(config *Config) error {
+	setString(&config.APIKey, os.Getenv(EnvAPIKey))
+	setString(&config.APISecret, os.Getenv(EnvAPISecret))
+	setString(&config.BaseURL, os.Getenv(EnvBaseURL))
+	setString(&config.OrganizationID, os.Getenv(EnvOrganizationID))
+	setString(&config.ProjectID, os.Getenv(EnvProjectID))
+
+	if value := os.Getenv(EnvVectorEncoding); value != "" {
+		config.VectorEncoding = VectorEncoding(value)
+	}
+	if value := os.Getenv(EnvTimeout); value != "" {
+		timeout, err := parseTimeout(value)
+		if err != nil {
+			return NewConfigError(EnvTimeout, err.Error())
+		}
+		config.Timeout = timeout
+	}
+	if value := os.Getenv(EnvRateLimit); value != "" {
+		rateLimit, err := strconv.Atoi(value)
+		if err != nil {
+			return NewConfigError(EnvRateLimit, fmt.Sprintf("invalid integer %q", value))
+		}
+		config.RateLimit = rateLimit
+	}
+	if value := os.Getenv(EnvMaxRetries); value != "" {
+		maxRetries, err := strconv.Atoi(value)
+		if err != nil {
+			return NewConfigError(EnvMaxRetries, fmt.Sprintf("invalid integer %q", value))
+		}
+		config.RetryConfig = defaultRetryConfig()
+		config.RetryConfig.MaxRetries = maxRetries
+	}
+	if value := os.Getenv(EnvDebug); value != "" {
+		debug, err := strconv.ParseBool(value)
+		if err != nil {
+			return NewConfigError(EnvDebug, fmt.Sprintf("invalid boolean %q", value))
+		}
+		config.Debug = debug
+	}
+	if value := os.Getenv(EnvSignRequests); value != "" {
+		signRequests, err := strconv.ParseBool(value)
+		if err != nil {
+			return NewConfigError(EnvSignRequests, fmt.Sprintf("invalid boolean %q", value))
+		}
+		config.SignRequests = signRequests
+	}
+	return nil
+}
+
+// mergeConfig copies the non-zero fields of src into dst
+func mergeConfig(dst, src *Config) {
+	setString(&dst.APIKey, src.APIKey)
+	setString(&dst.APISecret, src.APISecret)
+	setString(&dst.BaseURL, src.BaseURL)
+	setString(&dst.OrganizationID, src.OrganizationID)
+	setString(&dst.ProjectID, src.ProjectID)
+
+	if src.HTTPClient != nil {
+		dst.HTTPClient = src.HTTPClient
+	}
+	if src.Timeout != 0 {
+		dst.Timeout = src.Timeout
+	}
+	if src.RetryConfig != nil {
+		dst.RetryConfig = src.RetryConfig
+	}
+	if src.RateLimit != 0 {
+		dst.RateLimit = src.RateLimit
+	}
+	if src.Tracer != nil {
+		dst.Tracer = src.Tracer
+	}
+	if src.MeterProvider != nil {
+		dst.MeterProvider = src.MeterProvider
+	}
+	if src.Propagator != nil {
+		dst.Propagator = src.Propagator
+	}
+	if src.Debug {
+		dst.Debug = true
+	}
+	if src.SignRequests {
+		dst.SignRequests = true
+	}
+	if src.Middleware != nil {
+		dst.Middleware = src.Middleware
+	}
+	if src.TokenSource != nil {
+		dst.TokenSource = src.TokenSource
+	}
+	if src.CircuitBreaker != nil {
+		dst.CircuitBreaker = src.CircuitBreaker
+	}
+	if src.Logger != nil {
+		dst.Logger = src.Logger
+	}
+	if src.VectorEncoding != "" {
+		dst.VectorEncoding = src.VectorEncoding
+	}
+}
+
+// setString sets *dst to value unless value is empty
+func setString(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
+
+// parseTimeout parses a duration such as "30s", or a whole number of seconds
+func parseTimeout(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,198 @@
+package ainative
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigFile = `
+default_profile: prod
+profiles:
+  prod:
+    api_key: prod-key
+    organization_id: org-prod
+  staging:
+    api_key: staging-key
+    base_url: https://staging.api.ainative.studio
+    timeout: 10s
+  local:
+    api_key: local-key
+    base_url: http://localhost:8000
+    timeout: 5
+    rate_limit: 1000
+    max_retries: 0
+    debug: true
+    vector_encoding: base64
+`
+
+// isolateConfigEnv clears the AINATIVE_* variables and points the default
+// config location at an empty directory
+func isolateConfigEnv(t *testing.T) {
+	for _, name := range []string{
+		EnvAPIKey, EnvAPISecret, EnvBaseURL, EnvOrganizationID, EnvProjectID,
+		EnvTimeout, EnvRateLimit, EnvMaxRetries, EnvDebug, EnvSignRequests, EnvVectorEncoding, EnvProfile, EnvConfigFile,
+	} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+}
+
+// writeConfigFile writes contents to a config file and returns its path
+func writeConfigFile(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	isolateConfigEnv(t)
+
+	// A config that fails validation is still returned
+	config, err := LoadConfig()
+	assert.True(t, errors.Is(err, ErrConfig))
+	require.NotNil(t, config)
+	assert.Equal(t, DefaultBaseURL, config.BaseURL)
+
+	config, err = LoadConfig(WithOverrides(&Config{APIKey: "flag-key"}))
+	require.NoError(t, err)
+	assert.Equal(t, "flag-key", config.APIKey)
+	assert.Equal(t, DefaultBaseURL, config.BaseURL)
+	assert.Equal(t, DefaultTimeout, config.Timeout)
+	assert.Equal(t, DefaultRateLimit, config.RateLimit)
+	assert.Nil(t, config.RetryConfig)
+}
+
+func TestLoadConfig_Profiles(t *testing.T) {
+	isolateConfigEnv(t)
+	path := writeConfigFile(t, testConfigFile)
+
+	config, err := LoadConfig(WithConfigFile(path))
+	require.NoError(t, err)
+	assert.Equal(t, "prod-key", config.APIKey)
+	assert.Equal(t, "org-prod", config.OrganizationID)
+	assert.Equal(t, DefaultBaseURL, config.BaseURL)
+
+	config, err = LoadConfig(WithConfigFile(path), WithProfile("staging"))
+	require.NoError(t, err)
+	assert.Equal(t, "staging-key", config.APIKey)
+	assert.Equal(t, "https://staging.api.ainative.studio", config.BaseURL)
+	assert.Equal(t, 10*time.Second, config.Timeout)
+
+	t.Setenv(EnvProfile, "local")
+	config, err = LoadConfig(WithConfigFile(path))
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8000", config.BaseURL)
+	assert.Equal(t, 5*time.Second, config.Timeout)
+	assert.Equal(t, 1000, config.RateLimit)
+	require.NotNil(t, config.RetryConfig)
+	assert.Equal(t, 0, config.RetryConfig.MaxRetries)
+	assert.True(t, config.Debug)
+	assert.Equal(t, VectorEncodingBase64, config.VectorEncoding)
+
+	_, err = LoadConfig(WithConfigFile(path), WithProfile("missing"))
+	var configErr *ConfigError
+	require.True(t, errors.As(err, &configErr))
+	assert.Equal(t, "profile", configErr.Field)
+}
+
+func TestLoadConfig_DefaultLocation(t *testing.T) {
+	isolateConfigEnv(t)
+
+	dir := filepath.Join(os.Getenv("XDG_CONFIG_HOME"), "ainative")
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(testConfigFile), 0o600))
+
+	config, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "prod-key", config.APIKey)
+
+	// A missing file is only an error when named explicitly
+	t.Setenv(EnvConfigFile, filepath.Join(t.TempDir(), "missing.yaml"))
+	_, err = LoadConfig()
+	assert.True(t, errors.Is(err, ErrConfig))
+}
+
+func TestLoadConfig_Precedence(t *testing.T) {
+	isolateConfigEnv(t)
+	path := writeConfigFile(t, testConfigFile)
+
+	t.Setenv(EnvAPIKey, "env-key")
+	t.Setenv(EnvProjectID, "proj-env")
+	t.Setenv(EnvTimeout, "45s")
+	t.Setenv(EnvMaxRetries, "7")
+
+	config, err := LoadConfig(WithConfigFile(path), WithOverrides(&Config{ProjectID: "proj-flag"}))
+	require.NoError(t, err)
+	assert.Equal(t, "env-key", config.APIKey)
+	assert.Equal(t, "org-prod", config.OrganizationID)
+	assert.Equal(t, "proj-flag", config.ProjectID)
+	assert.Equal(t, 45*time.Second, config.Timeout)
+	require.NotNil(t, config.RetryConfig)
+	assert.Equal(t, 7, config.RetryConfig.MaxRetries)
+	assert.Equal(t, defaultRetryConfig().InitialDelay, config.RetryConfig.InitialDelay)
+}
+
+func TestLoadConfig_InvalidValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   string
+		value string
+		field string
+	}{
+		{"timeout", EnvTimeout, "soon", EnvTimeout},
+		{"rate limit", EnvRateLimit, "fast", EnvRateLimit},
+		{"debug", EnvDebug, "maybe", EnvDebug},
+		{"sign requests", EnvSignRequests, "sometimes", EnvSignRequests},
+		{"negative rate limit", EnvRateLimit, "-1", "rate_limit"},
+		{"base url", EnvBaseURL, "ftp://api.ainative.studio", "base_url"},
+		{"vector encoding", EnvVectorEncoding, "float16", "vector_encoding"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateConfigEnv(t)
+			t.Setenv(EnvAPIKey, "env-key")
+			t.Setenv(tt.env, tt.value)
+
+			_, err := LoadConfig()
+			var configErr *ConfigError
+			require.True(t, errors.As(err, &configErr))
+			assert.Equal(t, tt.field, configErr.Field)
+		})
+	}
+
+	isolateConfigEnv(t)
+	_, err := LoadConfig(WithConfigFile(writeConfigFile(t, "profiles: [")))
+	assert.True(t, errors.Is(err, ErrConfig))
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, (&Config{APIKey: "key"}).Validate())
+	assert.NoError(t, (&Config{APIKey: "key", BaseURL: "http://localhost:8000"}).Validate())
+
+	err := (&Config{
+		BaseURL:        "not a url",
+		Timeout:        -time.Second,
+		RateLimit:      -5,
+		RetryConfig:    &RetryConfig{MaxRetries: -1},
+		VectorEncoding: "float16",
+	}).Validate()
+
+	var errs ConfigErrors
+	require.True(t, errors.As(err, &errs))
+	fields := make([]string, len(errs))
+	for i, e := range errs {
+		fields[i] = e.Field
+	}
+	assert.Equal(t, []string{"api_key", "base_url", "timeout", "rate_limit", "vector_encoding", "retry_config.max_retries"}, fields)
+	assert.True(t, errors.Is(err, ErrConfig))
+
+	_, err = NewClient(&Config{APIKey: "key", Timeout: -time.Second})
+	assert.True(t, errors.Is(err, ErrConfig))
+}
@@ -27,9 +27,12 @@ type GenerateRequest struct {
 	Normalize bool     `json:"normalize"`
 }
 
-// GenerateResponse represents the response from generating embeddings
+// GenerateResponse represents the response from generating embeddings.
+// Compact embeddings are returned in Embeddings32 instead of Embeddings; use
+// Float32 to read either.
 type GenerateResponse struct {
 	Embeddings       [][]float64 `json:"embeddings"`
+	Embeddings32     [][]float32 `json:"-"`
 	Model            string      `json:"model"`
 	Dimensions       int         `json:"dimensions"`
 	Count            int         `json:"count"`
@@ -154,7 +157,7 @@ func (s *EmbeddingsService) Generate(ctx context.Context, texts []string, model
 
 	var result GenerateResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/embeddings/generate", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/embeddings/generate"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +172,7 @@ func (s *EmbeddingsService) Generate(ctx context.Context, texts []string, model
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeouts
-//   - projectID: Project ID (UUID string)
+//   - projectID: Project ID (UUID string, defaults to the client's scoped project)
 //   - texts: List of texts to embed and store (max 100)
 //   - metadataList: Optional metadata for each text (must match texts length)
 //   - namespace: Vector namespace (optional, defaults to "default")
@@ -204,6 +207,7 @@ func (s *EmbeddingsService) Generate(ctx context.Context, texts []string, model
 //	}
 //	fmt.Printf("Stored %d vectors\n", resp.VectorsStored)
 func (s *EmbeddingsService) EmbedAndStore(ctx context.Context, projectID string, texts []string, metadataList []map[string]interface{}, namespace, model string) (*EmbedAndStoreResponse, error) {
+	projectID = s.client.projectID(projectID)
 	if projectID == "" {
 		return nil, NewValidationError("project_id", "project ID is required", projectID)
 	}
@@ -239,7 +243,7 @@ func (s *EmbeddingsService) EmbedAndStore(ctx context.Context, projectID string,
 
 	var result EmbedAndStoreResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/embeddings/embed-and-store", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/embeddings/embed-and-store"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -254,12 +258,12 @@ func (s *EmbeddingsService) EmbedAndStore(ctx context.Context, projectID string,
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeouts
-//   - projectID: Project ID (UUID string)
+//   - projectID: Project ID (UUID string, defaults to the client's scoped project)
 //   - query: Natural language search query
 //   - limit: Maximum results (1-100, optional, defaults to 10)
 //   - threshold: Similarity threshold (0.0-1.0, optional, defaults to 0.7)
 //   - namespace: Vector namespace to search (optional, defaults to "default")
-//   - filterMetadata: Optional metadata filters (MongoDB-style)
+//   - filterMetadata: Optional metadata filters (MongoDB-style, see package filter)
 //   - model: Embedding model to use (optional, defaults to BAAI/bge-small-en-v1.5)
 //
 // Returns:
@@ -285,6 +289,7 @@ func (s *EmbeddingsService) EmbedAndStore(ctx context.Context, projectID string,
 //	    fmt.Printf("%s: %.2f\n", result.Document, result.Similarity)
 //	}
 func (s *EmbeddingsService) SemanticSearch(ctx context.Context, projectID, query string, limit int, threshold float64, namespace string, filterMetadata map[string]interface{}, model string) (*SemanticSearchResponse, error) {
+	projectID = s.client.projectID(projectID)
 	if projectID == "" {
 		return nil, NewValidationError("project_id", "project ID is required", projectID)
 	}
@@ -318,6 +323,10 @@ func (s *EmbeddingsService) SemanticSearch(ctx context.Context, projectID, query
 		model = "BAAI/bge-small-en-v1.5"
 	}
 
+	if err := validateFilter(filterMetadata); err != nil {
+		return nil, err
+	}
+
 	req := &SemanticSearchRequest{
 		ProjectID:      projectID,
 		Query:          query,
@@ -330,7 +339,7 @@ func (s *EmbeddingsService) SemanticSearch(ctx context.Context, projectID, query
 
 	var result SemanticSearchResponse
 
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/embeddings/semantic-search", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/embeddings/semantic-search"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -362,7 +371,7 @@ func (s *EmbeddingsService) SemanticSearch(ctx context.Context, projectID, query
 func (s *EmbeddingsService) ListModels(ctx context.Context) ([]EmbeddingModel, error) {
 	var result []EmbeddingModel
 
-	err := s.client.makeRequest(ctx, "GET", "/api/v1/embeddings/models", nil, &result)
+	err := s.client.makeRequest(ctx, "GET", endpoint("/api/v1/embeddings/models"), nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -393,7 +402,7 @@ func (s *EmbeddingsService) ListModels(ctx context.Context) ([]EmbeddingModel, e
 func (s *EmbeddingsService) HealthCheck(ctx context.Context) (*HealthCheckResponse, error) {
 	var result HealthCheckResponse
 
-	err := s.client.makeRequest(ctx, "GET", "/api/v1/embeddings/health", nil, &result)
+	err := s.client.makeRequest(ctx, "GET", endpoint("/api/v1/embeddings/health"), nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -425,7 +434,7 @@ func (s *EmbeddingsService) HealthCheck(ctx context.Context) (*HealthCheckRespon
 func (s *EmbeddingsService) GetUsage(ctx context.Context) (*UsageResponse, error) {
 	var result UsageResponse
 
-	err := s.client.makeRequest(ctx, "GET", "/api/v1/embeddings/usage", nil, &result)
+	err := s.client.makeRequest(ctx, "GET", endpoint("/api/v1/embeddings/usage"), nil, &result)
 	if err != nil {
 		return nil, err
 	}
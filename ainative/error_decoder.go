@@ -0,0 +1,243 @@
+package ainative
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// HeaderRequestID is the response header carrying the server request ID
+const HeaderRequestID = "X-Request-ID"
+
+// errorFields is an error body decoded member by member, so that a member of
+// an unexpected type does not prevent the others from being read. It covers
+// the error shapes returned by the API:
+//
+//	{"message": "...", "code": "..." or 123, "details": {...} or [...]}
+//	{"detail": "..."}
+//	{"detail": {"message": "...", "code": "..."}}
+//	{"detail": [{"loc": ["body", "name"], "msg": "...", "type": "..."}]}
+//	{"error": "..."} or {"error": {"message": "...", "code": "..."}}
+//	{"errors": [{"field": "...", "message": "..."}]} or {"errors": ["..."]}
+type errorFields map[string]json.RawMessage
+
+// string returns member key as a string. Numbers and booleans are returned
+// as their JSON text; other types yield "".
+func (f errorFields) string(key string) string {
+	raw := f[key]
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var scalar interface{}
+	if json.Unmarshal(raw, &scalar) == nil {
+		switch scalar.(type) {
+		case float64, bool:
+			return string(bytes.TrimSpace(raw))
+		}
+	}
+	return ""
+}
+
+// fieldError is a single field-level validation error
+type fieldError struct {
+	Loc     []interface{} `json:"loc"`
+	Msg     string        `json:"msg"`
+	Type    string        `json:"type"`
+	Input   interface{}   `json:"input"`
+	Field   string        `json:"field"`
+	Message string        `json:"message"`
+	Value   interface{}   `json:"value"`
+}
+
+// validationError converts the field error to a ValidationError
+func (f fieldError) validationError() *ValidationError {
+	field := f.Field
+	if field == "" {
+		field = locationField(f.Loc)
+	}
+
+	message := f.Msg
+	if message == "" {
+		message = f.Message
+	}
+
+	value := f.Input
+	if value == nil {
+		value = f.Value
+	}
+
+	return &ValidationError{Field: field, Message: message, Value: value}
+}
+
+// locationField joins a FastAPI error location into a dotted field name,
+// dropping the leading request part ("body", "query", "path", ...)
+func locationField(loc []interface{}) string {
+	parts := make([]string, 0, len(loc))
+	for i, part := range loc {
+		s := fmt.Sprint(part)
+		if i == 0 && len(loc) > 1 {
+			switch s {
+			case "body", "query", "path", "header", "cookie":
+				continue
+			}
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, ".")
+}
+
+// decodeAPIError builds an APIError from an error response. Unknown or
+// non-JSON bodies produce a generic message; the raw body is always kept.
+func decodeAPIError(statusCode int, header http.Header, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		RawBody:    body,
+	}
+
+	var fields errorFields
+	if len(body) > 0 && json.Unmarshal(body, &fields) == nil {
+		apiErr.decodeFields(fields)
+		apiErr.RequestID = fields.string("request_id")
+		apiErr.Timestamp = fields.string("timestamp")
+
+		apiErr.decodeNested(fields["detail"], "detail")
+		apiErr.decodeNested(fields["error"], "error")
+		apiErr.decodeNested(fields["errors"], "errors")
+	}
+
+	if apiErr.Message == "" && len(apiErr.ValidationErrors) > 0 {
+		apiErr.Message = validationMessage(apiErr.ValidationErrors)
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = fmt.Sprintf("API request failed with status %d", statusCode)
+	}
+	if apiErr.RequestID == "" && header != nil {
+		apiErr.RequestID = header.Get(HeaderRequestID)
+	}
+
+	return apiErr
+}
+
+// decodeFields reads message, code and details from fields, keeping a
+// message or code that is already set
+func (e *APIError) decodeFields(fields errorFields) {
+	if e.Message == "" {
+		e.Message = fields.string("message")
+	}
+	if e.Code == "" {
+		e.Code = fields.string("code")
+	}
+
+	var details interface{}
+	if json.Unmarshal(fields["details"], &details) != nil {
+		return
+	}
+	switch details := details.(type) {
+	case map[string]interface{}:
+		for k, v := range details {
+			e.setDetail(k, v)
+		}
+	case nil:
+	default:
+		e.setDetail("details", details)
+	}
+}
+
+// decodeNested handles the "detail", "error" and "errors" members, which may
+// be a string, an object with message/code, or a list. Only list entries that
+// name a field become ValidationErrors; other entries are joined into the
+// message.
+func (e *APIError) decodeNested(raw json.RawMessage, key string) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return
+	}
+
+	var message string
+	if json.Unmarshal(raw, &message) == nil {
+		if e.Message == "" {
+			e.Message = message
+		}
+		return
+	}
+
+	var items []json.RawMessage
+	if json.Unmarshal(raw, &items) == nil {
+		var messages []string
+		for _, item := range items {
+			if fieldErr, ok := decodeFieldError(item); ok {
+				e.ValidationErrors = append(e.ValidationErrors, fieldErr)
+			} else if message := listMessage(item); message != "" {
+				messages = append(messages, message)
+			}
+		}
+		if e.Message == "" && len(messages) > 0 {
+			e.Message = strings.Join(messages, "; ")
+		}
+		var list []interface{}
+		json.Unmarshal(raw, &list)
+		e.setDetail(key, list)
+		return
+	}
+
+	var nested errorFields
+	if json.Unmarshal(raw, &nested) == nil {
+		e.decodeFields(nested)
+	}
+}
+
+// decodeFieldError converts an error list entry naming a field, by "loc" or
+// "field", to a ValidationError
+func decodeFieldError(raw json.RawMessage) (*ValidationError, bool) {
+	var fields errorFields
+	if json.Unmarshal(raw, &fields) != nil || (fields["loc"] == nil && fields["field"] == nil) {
+		return nil, false
+	}
+
+	var fe fieldError
+	if json.Unmarshal(raw, &fe) != nil {
+		return nil, false
+	}
+	return fe.validationError(), true
+}
+
+// listMessage returns the message of an error list entry that does not name
+// a field: a bare string or an object with "message" or "msg"
+func listMessage(raw json.RawMessage) string {
+	var message string
+	if json.Unmarshal(raw, &message) == nil {
+		return message
+	}
+
+	var fields errorFields
+	if json.Unmarshal(raw, &fields) != nil {
+		return ""
+	}
+	if message := fields.string("message"); message != "" {
+		return message
+	}
+	return fields.string("msg")
+}
+
+// setDetail records a value in Details, creating the map if needed
+func (e *APIError) setDetail(key string, value interface{}) {
+	if e.Details == nil {
+		e.Details = make(map[string]interface{})
+	}
+	e.Details[key] = value
+}
+
+// validationMessage summarizes field errors in a single message
+func validationMessage(errs []*ValidationError) string {
+	parts := make([]string, len(errs))
+	for i, err := range errs {
+		if err.Field == "" {
+			parts[i] = err.Message
+		} else {
+			parts[i] = err.Field + ": " + err.Message
+		}
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
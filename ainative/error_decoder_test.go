@@ -0,0 +1,176 @@
+package ainative
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAPIError(t *testing.T) {
+	header := http.Header{}
+	header.Set(HeaderRequestID, "req-header")
+
+	tests := []struct {
+		name       string
+		body       string
+		message    string
+		code       string
+		requestID  string
+		validation []*ValidationError
+	}{
+		{
+			name:      "message body",
+			body:      `{"message": "Invalid request", "code": "INVALID_REQUEST", "request_id": "req-body"}`,
+			message:   "Invalid request",
+			code:      "INVALID_REQUEST",
+			requestID: "req-body",
+		},
+		{
+			name:      "fastapi string detail",
+			body:      `{"detail": "Project not found"}`,
+			message:   "Project not found",
+			requestID: "req-header",
+		},
+		{
+			name:      "fastapi object detail",
+			body:      `{"detail": {"message": "Quota exceeded", "code": "QUOTA_EXCEEDED"}}`,
+			message:   "Quota exceeded",
+			code:      "QUOTA_EXCEEDED",
+			requestID: "req-header",
+		},
+		{
+			name:      "fastapi validation detail",
+			body:      `{"detail": [{"loc": ["body", "name"], "msg": "field required", "type": "value_error.missing"}, {"loc": ["query", "limit"], "msg": "must be positive", "type": "value_error", "input": -1}]}`,
+			message:   "validation failed: name: field required; limit: must be positive",
+			requestID: "req-header",
+			validation: []*ValidationError{
+				{Field: "name", Message: "field required"},
+				{Field: "limit", Message: "must be positive", Value: float64(-1)},
+			},
+		},
+		{
+			name:      "error string",
+			body:      `{"error": "Internal failure"}`,
+			message:   "Internal failure",
+			requestID: "req-header",
+		},
+		{
+			name:      "errors list",
+			body:      `{"message": "Invalid vectors", "errors": [{"field": "vectors.0.values", "message": "dimension mismatch"}]}`,
+			message:   "Invalid vectors",
+			requestID: "req-header",
+			validation: []*ValidationError{
+				{Field: "vectors.0.values", Message: "dimension mismatch"},
+			},
+		},
+		{
+			name:      "numeric code and list details",
+			body:      `{"message": "Rate limited", "code": 4029, "details": ["retry later"], "request_id": "req-body"}`,
+			message:   "Rate limited",
+			code:      "4029",
+			requestID: "req-body",
+		},
+		{
+			name:      "mistyped message",
+			body:      `{"message": {"text": "nested"}, "code": "BAD_REQUEST", "detail": "Use detail instead"}`,
+			message:   "Use detail instead",
+			code:      "BAD_REQUEST",
+			requestID: "req-header",
+		},
+		{
+			name:      "errors string list",
+			body:      `{"errors": ["name is required", "limit must be positive"]}`,
+			message:   "name is required; limit must be positive",
+			requestID: "req-header",
+		},
+		{
+			name:      "non-JSON body",
+			body:      `<html>Bad Gateway</html>`,
+			message:   "API request failed with status 422",
+			requestID: "req-header",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := decodeAPIError(http.StatusUnprocessableEntity, header, []byte(tt.body))
+
+			assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+			assert.Equal(t, tt.message, apiErr.Message)
+			assert.Equal(t, tt.code, apiErr.Code)
+			assert.Equal(t, tt.requestID, apiErr.RequestID)
+			assert.Equal(t, tt.validation, apiErr.ValidationErrors)
+			assert.Equal(t, tt.body, string(apiErr.RawBody))
+		})
+	}
+}
+
+func TestDecodeAPIError_Details(t *testing.T) {
+	apiErr := decodeAPIError(http.StatusUnprocessableEntity, nil,
+		[]byte(`{"detail": [{"loc": ["body", "dimension"], "msg": "too large", "type": "value_error"}]}`))
+
+	require.Contains(t, apiErr.Details, "detail")
+	detail, ok := apiErr.Details["detail"].([]interface{})
+	require.True(t, ok)
+	assert.Len(t, detail, 1)
+
+	apiErr = decodeAPIError(http.StatusBadRequest, nil,
+		[]byte(`{"message": "bad", "details": {"limit": 100}}`))
+	assert.Equal(t, float64(100), apiErr.Details["limit"])
+
+	apiErr = decodeAPIError(http.StatusBadRequest, nil,
+		[]byte(`{"message": "bad", "details": ["first", "second"]}`))
+	assert.Equal(t, []interface{}{"first", "second"}, apiErr.Details["details"])
+
+	apiErr = decodeAPIError(http.StatusBadRequest, nil,
+		[]byte(`{"errors": ["missing name"]}`))
+	assert.Equal(t, []interface{}{"missing name"}, apiErr.Details["errors"])
+}
+
+func TestDecodeAPIError_MessageListsAreNotValidationErrors(t *testing.T) {
+	apiErr := decodeAPIError(http.StatusInternalServerError, nil,
+		[]byte(`{"errors": ["database unavailable"]}`))
+
+	assert.Equal(t, "database unavailable", apiErr.Message)
+	assert.Empty(t, apiErr.ValidationErrors)
+	assert.False(t, errors.Is(apiErr, ErrValidation))
+
+	apiErr = decodeAPIError(http.StatusBadRequest, nil,
+		[]byte(`{"errors": [{"message": "quota exceeded"}, {"field": "name", "message": "required"}]}`))
+	assert.Equal(t, "quota exceeded", apiErr.Message)
+	assert.Equal(t, []*ValidationError{{Field: "name", Message: "required"}}, apiErr.ValidationErrors)
+}
+
+func TestClient_DecodesValidationErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"detail": [{"loc": ["body", "name"], "msg": "field required", "type": "value_error.missing"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Projects.Create(context.Background(), &CreateProjectRequest{Name: "test"})
+	require.Error(t, err)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "validation failed: name: field required", apiErr.Message)
+	require.Len(t, apiErr.ValidationErrors, 1)
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "name", validationErr.Field)
+	assert.True(t, errors.Is(err, ErrValidation))
+	assert.True(t, errors.Is(err, ErrInvalidRequest))
+}
@@ -1,10 +1,31 @@
 package ainative
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 )
 
+// Sentinel errors for classifying SDK errors with errors.Is
+var (
+	ErrInvalidRequest     = errors.New("ainative: invalid request")
+	ErrUnauthorized       = errors.New("ainative: unauthorized")
+	ErrForbidden          = errors.New("ainative: forbidden")
+	ErrNotFound           = errors.New("ainative: not found")
+	ErrConflict           = errors.New("ainative: conflict")
+	ErrRateLimited        = errors.New("ainative: rate limited")
+	ErrServerError        = errors.New("ainative: server error")
+	ErrServiceUnavailable = errors.New("ainative: service unavailable")
+	ErrValidation         = errors.New("ainative: validation failed")
+	ErrNetwork            = errors.New("ainative: network error")
+	ErrTimeout            = errors.New("ainative: request timed out")
+	ErrCanceled           = errors.New("ainative: request canceled")
+	ErrCircuitOpen        = errors.New("ainative: circuit breaker open")
+	ErrConfig             = errors.New("ainative: invalid configuration")
+)
+
 // APIError represents an API error response
 type APIError struct {
 	// HTTP status code
@@ -24,6 +45,12 @@ type APIError struct {
 	
 	// Timestamp of the error
 	Timestamp string `json:"timestamp,omitempty"`
+	
+	// Field-level validation errors reported by the server
+	ValidationErrors []*ValidationError `json:"validation_errors,omitempty"`
+	
+	// Raw response body for debugging
+	RawBody []byte `json:"-"`
 }
 
 // Error implements the error interface
@@ -34,6 +61,54 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("AINative API error [%d]: %s", e.StatusCode, e.Message)
 }
 
+// Is reports whether the error matches one of the sentinel errors. The error
+// Code takes precedence; otherwise the HTTP status code is used.
+//
+// Example:
+//
+//	if errors.Is(err, ainative.ErrNotFound) {
+//	    // handle missing resource
+//	}
+func (e *APIError) Is(target error) bool {
+	if sentinel, ok := errorCodeSentinels[e.Code]; ok && sentinel == target {
+		return true
+	}
+
+	switch target {
+	case ErrInvalidRequest:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.IsRateLimitError()
+	case ErrServiceUnavailable:
+		return e.StatusCode == http.StatusServiceUnavailable
+	case ErrServerError:
+		return e.IsServerError()
+	}
+
+	return false
+}
+
+// Unwrap returns the field-level validation errors so that errors.As can
+// extract a *ValidationError from an APIError
+func (e *APIError) Unwrap() []error {
+	if len(e.ValidationErrors) == 0 {
+		return nil
+	}
+	errs := make([]error, len(e.ValidationErrors))
+	for i, err := range e.ValidationErrors {
+		errs[i] = err
+	}
+	return errs
+}
+
 // IsAuthenticationError returns true if the error is an authentication error
 func (e *APIError) IsAuthenticationError() bool {
 	return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
@@ -72,6 +147,11 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validation error for field '%s': %s", e.Field, e.Message)
 }
 
+// Is reports whether target is ErrValidation
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
 // NetworkError represents a network-related error
 type NetworkError struct {
 	Message string
@@ -91,6 +171,25 @@ func (e *NetworkError) Unwrap() error {
 	return e.Cause
 }
 
+// Is reports whether target is ErrNetwork, or ErrTimeout or ErrCanceled when
+// the underlying cause was a timeout or cancellation
+func (e *NetworkError) Is(target error) bool {
+	switch target {
+	case ErrNetwork:
+		return true
+	case ErrTimeout:
+		if errors.Is(e.Cause, context.DeadlineExceeded) {
+			return true
+		}
+		var netErr net.Error
+		return errors.As(e.Cause, &netErr) && netErr.Timeout()
+	case ErrCanceled:
+		return errors.Is(e.Cause, context.Canceled)
+	}
+
+	return false
+}
+
 // ConfigError represents a configuration error
 type ConfigError struct {
 	Field   string
@@ -102,6 +201,11 @@ func (e *ConfigError) Error() string {
 	return fmt.Sprintf("configuration error for field '%s': %s", e.Field, e.Message)
 }
 
+// Is reports whether target is ErrConfig
+func (e *ConfigError) Is(target error) bool {
+	return target == ErrConfig
+}
+
 // Common error codes
 const (
 	ErrorCodeInvalidRequest     = "INVALID_REQUEST"
@@ -113,6 +217,17 @@ const (
 	ErrorCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
 )
 
+// errorCodeSentinels maps API error codes to sentinel errors
+var errorCodeSentinels = map[string]error{
+	ErrorCodeInvalidRequest:     ErrInvalidRequest,
+	ErrorCodeUnauthorized:       ErrUnauthorized,
+	ErrorCodeForbidden:          ErrForbidden,
+	ErrorCodeNotFound:           ErrNotFound,
+	ErrorCodeRateLimit:          ErrRateLimited,
+	ErrorCodeInternalError:      ErrServerError,
+	ErrorCodeServiceUnavailable: ErrServiceUnavailable,
+}
+
 // NewAPIError creates a new API error
 func NewAPIError(statusCode int, message, code string) *APIError {
 	return &APIError{
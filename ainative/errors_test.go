@@ -0,0 +1,117 @@
+package ainative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *APIError
+		target error
+		want   bool
+	}{
+		{"bad request status", &APIError{StatusCode: 400}, ErrInvalidRequest, true},
+		{"unprocessable status", &APIError{StatusCode: 422}, ErrInvalidRequest, true},
+		{"unauthorized status", &APIError{StatusCode: 401}, ErrUnauthorized, true},
+		{"forbidden status", &APIError{StatusCode: 403}, ErrForbidden, true},
+		{"not found status", &APIError{StatusCode: 404}, ErrNotFound, true},
+		{"conflict status", &APIError{StatusCode: 409}, ErrConflict, true},
+		{"rate limit status", &APIError{StatusCode: 429}, ErrRateLimited, true},
+		{"server error status", &APIError{StatusCode: 500}, ErrServerError, true},
+		{"unavailable is server error", &APIError{StatusCode: 503}, ErrServerError, true},
+		{"unavailable status", &APIError{StatusCode: 503}, ErrServiceUnavailable, true},
+		{"code takes precedence", &APIError{StatusCode: 400, Code: ErrorCodeNotFound}, ErrNotFound, true},
+		{"mismatched status", &APIError{StatusCode: 404}, ErrUnauthorized, false},
+		{"client error is not server error", &APIError{StatusCode: 400}, ErrServerError, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errors.Is(tt.err, tt.target))
+		})
+	}
+}
+
+func TestErrors_IsAndAs(t *testing.T) {
+	validationErr := fmt.Errorf("create: %w", NewValidationError("name", "required", ""))
+	assert.True(t, errors.Is(validationErr, ErrValidation))
+	var ve *ValidationError
+	require.True(t, errors.As(validationErr, &ve))
+	assert.Equal(t, "name", ve.Field)
+
+	configErr := NewConfigError("api_key", "required")
+	assert.True(t, errors.Is(configErr, ErrConfig))
+	assert.False(t, errors.Is(configErr, ErrValidation))
+
+	circuitErr := &CircuitOpenError{Service: "/api/v1/zerodb", RetryAt: time.Now()}
+	assert.True(t, errors.Is(circuitErr, ErrCircuitOpen))
+
+	timeoutErr := NewNetworkError("request failed", fmt.Errorf("dial: %w", context.DeadlineExceeded))
+	assert.True(t, errors.Is(timeoutErr, ErrNetwork))
+	assert.True(t, errors.Is(timeoutErr, ErrTimeout))
+	assert.False(t, errors.Is(timeoutErr, ErrCanceled))
+	assert.True(t, errors.Is(timeoutErr, context.DeadlineExceeded))
+
+	canceledErr := NewNetworkError("request failed", context.Canceled)
+	assert.True(t, errors.Is(canceledErr, ErrCanceled))
+	assert.False(t, errors.Is(canceledErr, ErrTimeout))
+}
+
+func TestClient_ErrorClassification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Project not found", "code": "NOT_FOUND"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Projects.Get(context.Background(), "missing")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestClient_NetworkErrorClassification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:      "test-key",
+		BaseURL:     server.URL,
+		RetryConfig: &RetryConfig{MaxRetries: 0},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = client.ZeroDB.Projects.Get(ctx, "proj_123")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNetwork))
+	assert.True(t, errors.Is(err, ErrTimeout))
+
+	var netErr *NetworkError
+	assert.True(t, errors.As(err, &netErr))
+}
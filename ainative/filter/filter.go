@@ -0,0 +1,591 @@
+// Package filter builds typed metadata filters for ZeroDB vector and semantic
+// search.
+//
+// Filters serialize to the MongoDB-style documents the API expects, are
+// validated client-side, and can be evaluated locally against metadata, e.g.
+// to post-filter results or to back a fake server in tests.
+//
+// Example:
+//
+//	f := filter.Eq("category", "ml").
+//	    And(filter.Gte("year", 2020)).
+//	    Or(filter.In("lang", "go", "rust"))
+//
+//	results, err := client.ZeroDB.Vectors.Search(ctx, projectID, &ainative.VectorSearchRequest{
+//	    Vector: query,
+//	    Filter: f.Map(),
+//	})
+package filter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrInvalid is wrapped by every error reporting an invalid filter
+var ErrInvalid = errors.New("filter: invalid filter")
+
+// Op is a filter operator
+type Op string
+
+const (
+	OpEq     Op = "$eq"
+	OpNe     Op = "$ne"
+	OpGt     Op = "$gt"
+	OpGte    Op = "$gte"
+	OpLt     Op = "$lt"
+	OpLte    Op = "$lte"
+	OpIn     Op = "$in"
+	OpNin    Op = "$nin"
+	OpExists Op = "$exists"
+	OpAnd    Op = "$and"
+	OpOr     Op = "$or"
+)
+
+// Filter is a metadata filter expression. The zero value matches everything
+// and serializes to an empty document.
+type Filter struct {
+	op       Op
+	field    string
+	value    interface{}
+	children []Filter
+}
+
+// Eq matches metadata whose field equals value, or contains it when the
+// field is a list
+func Eq(field string, value interface{}) Filter {
+	return Filter{op: OpEq, field: field, value: value}
+}
+
+// Ne matches metadata whose field is missing or does not equal value
+func Ne(field string, value interface{}) Filter {
+	return Filter{op: OpNe, field: field, value: value}
+}
+
+// Gt matches metadata whose field is greater than value
+func Gt(field string, value interface{}) Filter {
+	return Filter{op: OpGt, field: field, value: value}
+}
+
+// Gte matches metadata whose field is greater than or equal to value
+func Gte(field string, value interface{}) Filter {
+	return Filter{op: OpGte, field: field, value: value}
+}
+
+// Lt matches metadata whose field is less than value
+func Lt(field string, value interface{}) Filter {
+	return Filter{op: OpLt, field: field, value: value}
+}
+
+// Lte matches metadata whose field is less than or equal to value
+func Lte(field string, value interface{}) Filter {
+	return Filter{op: OpLte, field: field, value: value}
+}
+
+// In matches metadata whose field equals one of values
+func In(field string, values ...interface{}) Filter {
+	return Filter{op: OpIn, field: field, value: values}
+}
+
+// Nin matches metadata whose field is missing or equals none of values
+func Nin(field string, values ...interface{}) Filter {
+	return Filter{op: OpNin, field: field, value: values}
+}
+
+// Exists matches metadata that has field, or lacks it when exists is false
+func Exists(field string, exists bool) Filter {
+	return Filter{op: OpExists, field: field, value: exists}
+}
+
+// And matches metadata matching every filter
+func And(filters ...Filter) Filter {
+	return combine(OpAnd, filters)
+}
+
+// Or matches metadata matching any filter
+func Or(filters ...Filter) Filter {
+	return combine(OpOr, filters)
+}
+
+// And returns a filter matching f and every one of others
+func (f Filter) And(others ...Filter) Filter {
+	return combine(OpAnd, append([]Filter{f}, others...))
+}
+
+// Or returns a filter matching f or any of others
+func (f Filter) Or(others ...Filter) Filter {
+	return combine(OpOr, append([]Filter{f}, others...))
+}
+
+// combine joins filters with op, flattening nested filters using the same
+// operator and dropping empty ones
+func combine(op Op, filters []Filter) Filter {
+	var children []Filter
+	for _, f := range filters {
+		switch {
+		case f.IsZero():
+		case f.op == op:
+			children = append(children, f.children...)
+		default:
+			children = append(children, f)
+		}
+	}
+
+	if len(children) == 1 {
+		return children[0]
+	}
+	if len(children) == 0 {
+		return Filter{}
+	}
+	return Filter{op: op, children: children}
+}
+
+// IsZero reports whether f is the empty filter
+func (f Filter) IsZero() bool {
+	return f.op == ""
+}
+
+// Op returns the filter's operator
+func (f Filter) Op() Op {
+	return f.op
+}
+
+// Field returns the field a comparison filter applies to
+func (f Filter) Field() string {
+	return f.field
+}
+
+// Map returns the filter in the API's format, for VectorSearchRequest.Filter
+// and similar fields. It returns nil for the empty filter.
+func (f Filter) Map() map[string]interface{} {
+	switch f.op {
+	case "":
+		return nil
+	case OpAnd, OpOr:
+		children := make([]interface{}, len(f.children))
+		for i, child := range f.children {
+			children[i] = child.Map()
+		}
+		return map[string]interface{}{string(f.op): children}
+	}
+	return map[string]interface{}{f.field: map[string]interface{}{string(f.op): f.value}}
+}
+
+// MarshalJSON implements json.Marshaler
+func (f Filter) MarshalJSON() ([]byte, error) {
+	if f.IsZero() {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(f.Map())
+}
+
+// String returns the filter as JSON
+func (f Filter) String() string {
+	data, err := f.MarshalJSON()
+	if err != nil {
+		return fmt.Sprintf("<invalid filter: %v>", err)
+	}
+	return string(data)
+}
+
+// Validate checks field names, operators and operand types
+func (f Filter) Validate() error {
+	switch f.op {
+	case "":
+		return nil
+	case OpAnd, OpOr:
+		if len(f.children) == 0 {
+			return invalid("%s requires at least one filter", f.op)
+		}
+		for _, child := range f.children {
+			if err := child.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if f.field == "" {
+		return invalid("%s requires a field name", f.op)
+	}
+	if strings.HasPrefix(f.field, "$") {
+		return invalid("field %q must not start with $", f.field)
+	}
+
+	switch f.op {
+	case OpEq, OpNe:
+		if !isScalar(f.value) {
+			return invalid("%s on %q requires a string, number, bool or null, got %T", f.op, f.field, f.value)
+		}
+	case OpGt, OpGte, OpLt, OpLte:
+		if _, ok := toFloat(f.value); !ok {
+			if _, ok := f.value.(string); !ok {
+				return invalid("%s on %q requires a number or string, got %T", f.op, f.field, f.value)
+			}
+		}
+	case OpIn, OpNin:
+		values, ok := f.value.([]interface{})
+		if !ok || len(values) == 0 {
+			return invalid("%s on %q requires a non-empty list", f.op, f.field)
+		}
+		for _, value := range values {
+			if !isScalar(value) {
+				return invalid("%s on %q requires scalar values, got %T", f.op, f.field, value)
+			}
+		}
+	case OpExists:
+		if _, ok := f.value.(bool); !ok {
+			return invalid("%s on %q requires a bool, got %T", f.op, f.field, f.value)
+		}
+	default:
+		return invalid("unsupported operator %s", f.op)
+	}
+	return nil
+}
+
+// Parse converts a filter document in the API's format into a Filter,
+// validating it. Plain values are equality tests, and a document with
+// several fields or operators is the And of each.
+func Parse(doc map[string]interface{}) (Filter, error) {
+	var filters []Filter
+	for _, key := range sortedKeys(doc) {
+		value := doc[key]
+
+		switch Op(key) {
+		case OpAnd, OpOr:
+			list, ok := toList(value)
+			if !ok || len(list) == 0 {
+				return Filter{}, invalid("%s requires a non-empty list of filters", key)
+			}
+			children := make([]Filter, len(list))
+			for i, item := range list {
+				child, ok := item.(map[string]interface{})
+				if !ok {
+					return Filter{}, invalid("%s requires a list of filters, got %T", key, item)
+				}
+				parsed, err := Parse(child)
+				if err != nil {
+					return Filter{}, err
+				}
+				children[i] = parsed
+			}
+			filters = append(filters, Filter{op: Op(key), children: children})
+			continue
+		}
+
+		if strings.HasPrefix(key, "$") {
+			return Filter{}, invalid("unsupported operator %s", key)
+		}
+
+		ops, ok := value.(map[string]interface{})
+		if !ok {
+			filters = append(filters, Eq(key, value))
+			continue
+		}
+		for _, op := range sortedKeys(ops) {
+			operand := ops[op]
+			if Op(op) == OpIn || Op(op) == OpNin {
+				list, ok := toList(operand)
+				if !ok {
+					return Filter{}, invalid("%s on %q requires a non-empty list", op, key)
+				}
+				operand = list
+			}
+			filters = append(filters, Filter{op: Op(op), field: key, value: operand})
+		}
+	}
+
+	f := Filter{}
+	switch len(filters) {
+	case 0:
+	case 1:
+		f = filters[0]
+	default:
+		f = Filter{op: OpAnd, children: filters}
+	}
+
+	if err := f.Validate(); err != nil {
+		return Filter{}, err
+	}
+	return f, nil
+}
+
+// Check validates the operands of the operators this package knows in a raw
+// filter document, such as VectorSearchRequest.Filter. Unlike Parse it never
+// rejects a document the server may accept: other operators, list equality
+// and embedded documents (objects with keys not starting with $) pass
+// through unchecked.
+func Check(doc map[string]interface{}) error {
+	for _, key := range sortedKeys(doc) {
+		value := doc[key]
+
+		switch Op(key) {
+		case OpAnd, OpOr:
+			list, ok := toList(value)
+			if !ok || len(list) == 0 {
+				return invalid("%s requires a non-empty list of filters", key)
+			}
+			for _, item := range list {
+				child, ok := item.(map[string]interface{})
+				if !ok {
+					return invalid("%s requires a list of filters, got %T", key, item)
+				}
+				if err := Check(child); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		ops, ok := value.(map[string]interface{})
+		if strings.HasPrefix(key, "$") || !ok || !isOperatorDoc(ops) {
+			continue
+		}
+		for _, op := range sortedKeys(ops) {
+			operand := ops[op]
+			switch Op(op) {
+			case OpGt, OpGte, OpLt, OpLte, OpExists:
+			case OpIn, OpNin:
+				if list, ok := toList(operand); ok {
+					operand = list
+				}
+			default:
+				// Equality may compare lists or documents; other operators
+				// are left to the server
+				continue
+			}
+			if err := (Filter{op: Op(op), field: key, value: operand}).Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isOperatorDoc reports whether every key of doc is an operator
+func isOperatorDoc(doc map[string]interface{}) bool {
+	for key := range doc {
+		if !strings.HasPrefix(key, "$") {
+			return false
+		}
+	}
+	return len(doc) > 0
+}
+
+// Match reports whether metadata satisfies f. Fields may name nested values
+// with dots, e.g. "author.name". Numbers of any type compare by value.
+func (f Filter) Match(metadata map[string]interface{}) bool {
+	switch f.op {
+	case "":
+		return true
+	case OpAnd:
+		for _, child := range f.children {
+			if !child.Match(metadata) {
+				return false
+			}
+		}
+		return true
+	case OpOr:
+		for _, child := range f.children {
+			if child.Match(metadata) {
+				return true
+			}
+		}
+		return false
+	}
+
+	value, found := lookup(metadata, f.field)
+
+	switch f.op {
+	case OpEq:
+		return found && contains(value, f.value)
+	case OpNe:
+		return !found || !contains(value, f.value)
+	case OpIn:
+		return found && containsAny(value, f.value)
+	case OpNin:
+		return !found || !containsAny(value, f.value)
+	case OpExists:
+		want, _ := f.value.(bool)
+		return found == want
+	case OpGt, OpGte, OpLt, OpLte:
+		if !found {
+			return false
+		}
+		cmp, ok := compare(value, f.value)
+		if !ok {
+			return false
+		}
+		switch f.op {
+		case OpGt:
+			return cmp > 0
+		case OpGte:
+			return cmp >= 0
+		case OpLt:
+			return cmp < 0
+		default:
+			return cmp <= 0
+		}
+	}
+	return false
+}
+
+// lookup returns the value at a dotted path in metadata
+func lookup(metadata map[string]interface{}, field string) (interface{}, bool) {
+	if value, ok := metadata[field]; ok {
+		return value, true
+	}
+
+	var current interface{} = metadata
+	for _, part := range strings.Split(field, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// contains reports whether value equals want or, for a list, has an element
+// equal to want
+func contains(value, want interface{}) bool {
+	if list, ok := value.([]interface{}); ok {
+		for _, item := range list {
+			if equal(item, want) {
+				return true
+			}
+		}
+		return false
+	}
+	return equal(value, want)
+}
+
+// containsAny reports whether value contains any of wants
+func containsAny(value, wants interface{}) bool {
+	list, _ := wants.([]interface{})
+	for _, want := range list {
+		if contains(value, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// equal compares scalars, treating numbers of any type by value
+func equal(a, b interface{}) bool {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+	return isScalar(a) && a == b
+}
+
+// compare orders two numbers or two strings
+func compare(a, b interface{}) (int, bool) {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		}
+		return 0, true
+	}
+
+	x, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	y, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(x, y), true
+}
+
+// toFloat converts a Go or JSON number to float64
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// toList converts a slice of any element type to []interface{}
+func toList(v interface{}) ([]interface{}, bool) {
+	if list, ok := v.([]interface{}); ok {
+		return list, true
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	list := make([]interface{}, rv.Len())
+	for i := range list {
+		list[i] = rv.Index(i).Interface()
+	}
+	return list, true
+}
+
+// isScalar reports whether v is a string, number, bool or nil
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case nil, string, bool:
+		return true
+	}
+	_, ok := toFloat(v)
+	return ok
+}
+
+// sortedKeys returns the keys of m in order, so parsing is deterministic
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// invalid returns an error wrapping ErrInvalid
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalid, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,167 @@
+package filter
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Serialization(t *testing.T) {
+	f := Eq("category", "ml").And(Gte("year", 2020)).Or(In("lang", "go", "rust"))
+
+	data, err := json.Marshal(f)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"$or": [
+		{"$and": [{"category": {"$eq": "ml"}}, {"year": {"$gte": 2020}}]},
+		{"lang": {"$in": ["go", "rust"]}}
+	]}`, string(data))
+
+	// Chained operators of the same kind are flattened
+	flat := Eq("a", 1).And(Eq("b", 2)).And(Eq("c", 3), Filter{})
+	assert.JSONEq(t, `{"$and": [{"a": {"$eq": 1}}, {"b": {"$eq": 2}}, {"c": {"$eq": 3}}]}`, flat.String())
+
+	assert.Nil(t, Filter{}.Map())
+	assert.Equal(t, "{}", And().String())
+	assert.Equal(t, Exists("x", true), Or(Exists("x", true)))
+}
+
+func TestFilter_Validate(t *testing.T) {
+	valid := []Filter{
+		{},
+		Eq("category", nil),
+		Ne("score", 0.5),
+		Lt("name", "m"),
+		Nin("lang", "go"),
+		Exists("deleted", false),
+	}
+	for _, f := range valid {
+		assert.NoError(t, f.Validate(), f.String())
+	}
+
+	invalid := map[string]Filter{
+		"empty field":   Eq("", "x"),
+		"operator name": Eq("$where", "x"),
+		"list equality": Eq("tags", []string{"a"}),
+		"range on bool": Gt("active", true),
+		"empty in":      In("lang"),
+		"nested in":     In("lang", []string{"go"}),
+		"nested child":  And(Eq("a", 1), Gt("b", map[string]interface{}{})),
+	}
+	for name, f := range invalid {
+		err := f.Validate()
+		assert.True(t, errors.Is(err, ErrInvalid), "%s: %v", name, err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"category": "ml",
+		"year": {"$gte": 2020, "$lt": 2025},
+		"$or": [{"lang": {"$in": ["go", "rust"]}}, {"featured": true}]
+	}`), &doc))
+
+	f, err := Parse(doc)
+	require.NoError(t, err)
+	assert.Equal(t, OpAnd, f.Op())
+
+	assert.True(t, f.Match(map[string]interface{}{"category": "ml", "year": 2021, "lang": "go"}))
+	assert.True(t, f.Match(map[string]interface{}{"category": "ml", "year": 2024.0, "featured": true}))
+	assert.False(t, f.Match(map[string]interface{}{"category": "ml", "year": 2025, "lang": "go"}))
+	assert.False(t, f.Match(map[string]interface{}{"category": "ml", "year": 2021}))
+
+	// Go values are accepted as well as decoded JSON
+	f, err = Parse(map[string]interface{}{"lang": map[string]interface{}{"$nin": []string{"java"}}})
+	require.NoError(t, err)
+	assert.True(t, f.Match(map[string]interface{}{"lang": "go"}))
+
+	roundTrip, err := Parse(Eq("a", 1).Or(Exists("b", true)).Map())
+	require.NoError(t, err)
+	assert.Equal(t, Eq("a", 1).Or(Exists("b", true)), roundTrip)
+
+	for _, bad := range []map[string]interface{}{
+		{"year": map[string]interface{}{"$regex": "^20"}},
+		{"$not": map[string]interface{}{"a": 1}},
+		{"$and": []interface{}{}},
+		{"$or": []interface{}{"a"}},
+		{"lang": map[string]interface{}{"$in": "go"}},
+	} {
+		_, err := Parse(bad)
+		assert.True(t, errors.Is(err, ErrInvalid), "%v: %v", bad, err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	passThrough := []map[string]interface{}{
+		{},
+		{"title": map[string]interface{}{"$regex": "^intro"}},
+		{"$not": map[string]interface{}{"a": 1}},
+		{"$nor": []interface{}{map[string]interface{}{"a": 1}}},
+		{"tags": []interface{}{"go", "db"}},
+		{"tags": map[string]interface{}{"$eq": []interface{}{"go"}}},
+		{"author": map[string]interface{}{"name": "ada"}},
+		{"author": map[string]interface{}{"name": "ada", "$gt": true}},
+		{"$and": []map[string]interface{}{{"year": map[string]interface{}{"$gte": 2020}}}},
+	}
+	for _, doc := range passThrough {
+		assert.NoError(t, Check(doc), "%v", doc)
+	}
+
+	for _, bad := range []map[string]interface{}{
+		{"year": map[string]interface{}{"$gt": true}},
+		{"lang": map[string]interface{}{"$in": "go"}},
+		{"deleted": map[string]interface{}{"$exists": "yes"}},
+		{"$and": []interface{}{}},
+		{"$or": []interface{}{map[string]interface{}{"lang": map[string]interface{}{"$nin": []interface{}{}}}}},
+	} {
+		err := Check(bad)
+		assert.True(t, errors.Is(err, ErrInvalid), "%v: %v", bad, err)
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	metadata := map[string]interface{}{
+		"category": "ml",
+		"year":     float64(2021),
+		"rating":   4.5,
+		"tags":     []interface{}{"go", "vectors"},
+		"author":   map[string]interface{}{"name": "ada"},
+		"draft":    false,
+	}
+
+	tests := []struct {
+		filter Filter
+		want   bool
+	}{
+		{Filter{}, true},
+		{Eq("category", "ml"), true},
+		{Eq("year", 2021), true},
+		{Eq("tags", "go"), true},
+		{Eq("tags", "rust"), false},
+		{Ne("category", "ml"), false},
+		{Ne("missing", "x"), true},
+		{Gt("rating", 4), true},
+		{Gte("year", int64(2021)), true},
+		{Lt("year", 2021), false},
+		{Lte("category", "ml"), true},
+		{Gt("category", 1), false},
+		{Gt("missing", 1), false},
+		{In("tags", "rust", "vectors"), true},
+		{In("category", "nlp", "cv"), false},
+		{Nin("category", "nlp", "cv"), true},
+		{Nin("missing", "x"), true},
+		{Exists("draft", true), true},
+		{Exists("missing", false), true},
+		{Eq("author.name", "ada"), true},
+		{Exists("author.email", true), false},
+		{Eq("category", "ml").And(Gt("year", 2022)), false},
+		{Eq("category", "ml").And(Gt("year", 2022)).Or(Eq("draft", false)), true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.filter.Match(metadata), tt.filter.String())
+	}
+}
@@ -0,0 +1,61 @@
+package ainative
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sync/atomic"
+)
+
+// HeaderIdempotencyKey is the header carrying the idempotency key of a mutating request
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// contextIdempotencyKey is a key set by WithIdempotencyKey, used by one
+// request only
+type contextIdempotencyKey struct {
+	key  string
+	used atomic.Bool
+}
+
+// WithIdempotencyKey returns a context that makes the next mutating request
+// made with it use key instead of an auto-generated one. Later requests with
+// the same context, such as the further batches of a bulk upsert, get keys of
+// their own. To retry a call from your own code, pass a new context with the
+// same key so the server can deduplicate it.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, &contextIdempotencyKey{key: key})
+}
+
+// detachCallValues returns ctx without the values that belong to a single
+// call, the idempotency key and ResponseMeta, for requests the SDK makes on
+// its own such as bulk upsert batches and pager fetches
+func detachCallValues(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, idempotencyKeyContextKey{}, nil)
+	return context.WithValue(ctx, responseMetaContextKey{}, nil)
+}
+
+// idempotencyKey returns the unused key from ctx, generating a new one if
+// none was set
+func idempotencyKey(ctx context.Context) (string, error) {
+	if v, ok := ctx.Value(idempotencyKeyContextKey{}).(*contextIdempotencyKey); ok && v.key != "" {
+		if v.used.CompareAndSwap(false, true) {
+			return v.key, nil
+		}
+	}
+	return newIdempotencyKey()
+}
+
+// newIdempotencyKey generates a random UUIDv4 string
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("ainative: failed to generate idempotency key: %w", err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,109 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIdempotencyKey(t *testing.T) {
+	uuidPattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	first, err := newIdempotencyKey()
+	require.NoError(t, err)
+	second, err := newIdempotencyKey()
+	require.NoError(t, err)
+
+	assert.Regexp(t, uuidPattern, first)
+	assert.Regexp(t, uuidPattern, second)
+	assert.NotEqual(t, first, second)
+}
+
+func TestIdempotencyKey_StableAcrossRetries(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(HeaderIdempotencyKey))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(AgentSwarm{ID: "swarm_123"})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:      "test-key",
+		BaseURL:     server.URL,
+		RetryConfig: &RetryConfig{MaxRetries: 2, InitialDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	req := &StartSwarmRequest{
+		ProjectID: "proj_123",
+		Objective: "test",
+		Agents:    []AgentConfig{{Type: AgentTypeAnalyzer, Count: 1}},
+	}
+	swarm, err := client.AgentSwarm.Start(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "swarm_123", swarm.ID)
+	require.Len(t, keys, 2)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+
+	// A new logical call gets a new key
+	_, err = client.AgentSwarm.Start(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+	assert.NotEqual(t, keys[0], keys[2])
+}
+
+func TestIdempotencyKey_ContextOverrideAndGET(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Method+" "+r.Header.Get(HeaderIdempotencyKey))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	ctx := WithIdempotencyKey(context.Background(), "task-create-42")
+	_, err = client.AgentOrchestration.CreateTask(ctx, &CreateTaskRequest{
+		AgentID:     "agent_1",
+		TaskType:    "analysis",
+		Description: "analyze",
+	})
+	require.NoError(t, err)
+
+	_, err = client.AgentSwarm.Get(context.Background(), "swarm_123")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"POST task-create-42", "GET "}, seen)
+
+	// The key is used by one request only
+	_, err = client.AgentOrchestration.CreateTask(ctx, &CreateTaskRequest{
+		AgentID:     "agent_1",
+		TaskType:    "analysis",
+		Description: "analyze again",
+	})
+	require.NoError(t, err)
+	require.Len(t, seen, 3)
+	assert.NotEqual(t, "POST task-create-42", seen[2])
+	assert.Regexp(t, `^POST [0-9a-f-]{36}$`, seen[2])
+}
@@ -0,0 +1,138 @@
+package ainative
+
+import "context"
+
+// The interfaces below describe each service exposed by Client. Code that
+// depends on a service can accept its interface instead of the concrete type,
+// so tests can inject a fake such as the mocks in package ainativemock.
+//
+// Mocks are generated from this file; run go generate ./ainative/ainativemock
+// after changing an interface.
+
+// ProjectsAPI is the interface implemented by ProjectsService
+type ProjectsAPI interface {
+	Create(ctx context.Context, req *CreateProjectRequest) (*Project, error)
+	List(ctx context.Context, req *ListProjectsRequest) (*ListProjectsResponse, error)
+	ListAll(ctx context.Context, req *ListProjectsRequest) *Pager[Project]
+	Get(ctx context.Context, projectID string) (*Project, error)
+	Update(ctx context.Context, projectID string, req *UpdateProjectRequest) (*Project, error)
+	Suspend(ctx context.Context, projectID string, reason string) error
+	Activate(ctx context.Context, projectID string) error
+	Delete(ctx context.Context, projectID string) error
+}
+
+// VectorsAPI is the interface implemented by VectorsService
+type VectorsAPI interface {
+	Search(ctx context.Context, projectID string, req *VectorSearchRequest) (*VectorSearchResponse, error)
+	Upsert(ctx context.Context, projectID string, req *UpsertVectorsRequest) (*UpsertVectorsResponse, error)
+	Fetch(ctx context.Context, projectID string, req *FetchVectorsRequest) (*FetchVectorsResponse, error)
+	Delete(ctx context.Context, projectID string, req *DeleteVectorsRequest) (*DeleteVectorsResponse, error)
+	UpdateMetadata(ctx context.Context, projectID, vectorID string, req *UpdateVectorMetadataRequest) (*VectorItem, error)
+	ListIDs(ctx context.Context, projectID string, req *ListVectorIDsRequest) (*ListVectorIDsResponse, error)
+	ListAllIDs(ctx context.Context, projectID string, req *ListVectorIDsRequest) *Pager[string]
+	GetStats(ctx context.Context, projectID, namespace string) (*VectorStats, error)
+	BulkUpsert(ctx context.Context, projectID string, vectors []VectorItem, opts *BulkUpsertOptions) (*BulkUpsertResult, error)
+	BulkUpsertStream(ctx context.Context, projectID string, vectors <-chan VectorItem, opts *BulkUpsertOptions) (*BulkUpsertResult, error)
+}
+
+// NamespacesAPI is the interface implemented by NamespacesService
+type NamespacesAPI interface {
+	List(ctx context.Context, projectID string, req *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	ListAll(ctx context.Context, projectID string, req *ListNamespacesRequest) *Pager[Namespace]
+	Describe(ctx context.Context, projectID, namespace string) (*Namespace, error)
+	Delete(ctx context.Context, projectID, namespace string) (*DeleteVectorsResponse, error)
+	Copy(ctx context.Context, projectID, namespace string, req *CopyNamespaceRequest) (*Namespace, error)
+	Rename(ctx context.Context, projectID, namespace string, req *RenameNamespaceRequest) (*Namespace, error)
+}
+
+// MemoryAPI is the interface implemented by MemoryService
+type MemoryAPI interface {
+	Create(ctx context.Context, req *CreateMemoryRequest) (*MemoryItem, error)
+	Search(ctx context.Context, req *SearchMemoryRequest) (*SearchMemoryResponse, error)
+}
+
+// EmbeddingsAPI is the interface implemented by EmbeddingsService
+type EmbeddingsAPI interface {
+	Generate(ctx context.Context, texts []string, model string, normalize bool) (*GenerateResponse, error)
+	EmbedAndStore(ctx context.Context, projectID string, texts []string, metadataList []map[string]interface{}, namespace, model string) (*EmbedAndStoreResponse, error)
+	SemanticSearch(ctx context.Context, projectID, query string, limit int, threshold float64, namespace string, filterMetadata map[string]interface{}, model string) (*SemanticSearchResponse, error)
+	ListModels(ctx context.Context) ([]EmbeddingModel, error)
+	HealthCheck(ctx context.Context) (*HealthCheckResponse, error)
+	GetUsage(ctx context.Context) (*UsageResponse, error)
+}
+
+// SwarmAPI is the interface implemented by AgentSwarmService
+type SwarmAPI interface {
+	Start(ctx context.Context, req *StartSwarmRequest) (*AgentSwarm, error)
+	Get(ctx context.Context, swarmID string) (*AgentSwarm, error)
+	List(ctx context.Context, req *ListSwarmsRequest) (*ListSwarmsResponse, error)
+	ListAll(ctx context.Context, req *ListSwarmsRequest) *Pager[AgentSwarm]
+	Stop(ctx context.Context, swarmID string) error
+	Pause(ctx context.Context, swarmID string) error
+	Resume(ctx context.Context, swarmID string) error
+	Orchestrate(ctx context.Context, req *OrchestrationRequest) (*OrchestrationResponse, error)
+	GetTask(ctx context.Context, taskID string) (*OrchestrationTask, error)
+	ListAgentTypes(ctx context.Context) ([]AgentType, error)
+	GetSwarmMetrics(ctx context.Context, swarmID string) (*SwarmMetrics, error)
+}
+
+// OrchestrationAPI is the interface implemented by AgentOrchestrationService
+type OrchestrationAPI interface {
+	CreateTask(ctx context.Context, req *CreateTaskRequest) (*CreateTaskResponse, error)
+	ListTasks(ctx context.Context, req *ListTasksRequest) (*ListTasksResponse, error)
+	ListAllTasks(ctx context.Context, req *ListTasksRequest) *Pager[Task]
+	GetTaskStatus(ctx context.Context, taskID string) (*TaskStatusResponse, error)
+	ExecuteTask(ctx context.Context, req *ExecuteTaskRequest) (*ExecuteTaskResponse, error)
+	CreateTaskSequence(ctx context.Context, req *CreateTaskSequenceRequest) (*CreateTaskSequenceResponse, error)
+}
+
+// CoordinationAPI is the interface implemented by AgentCoordinationService
+type CoordinationAPI interface {
+	SendMessage(ctx context.Context, req *SendMessageRequest) (*SendMessageResponse, error)
+	DistributeTasks(ctx context.Context, req *DistributeTasksRequest) (*DistributeTasksResponse, error)
+	GetWorkloadStats(ctx context.Context, req *GetWorkloadStatsRequest) (*GetWorkloadStatsResponse, error)
+}
+
+// LearningAPI is the interface implemented by AgentLearningService
+type LearningAPI interface {
+	SubmitFeedback(ctx context.Context, req *SubmitFeedbackRequest) (*SubmitFeedbackResponse, error)
+	GetPerformanceMetrics(ctx context.Context, req *GetPerformanceMetricsRequest) (*GetPerformanceMetricsResponse, error)
+	CompareAgents(ctx context.Context, req *CompareAgentsRequest) (*CompareAgentsResponse, error)
+}
+
+// StateAPI is the interface implemented by AgentStateService
+type StateAPI interface {
+	GetState(ctx context.Context, req *GetStateRequest) (*GetStateResponse, error)
+	CreateCheckpoint(ctx context.Context, req *CreateCheckpointRequest) (*CreateCheckpointResponse, error)
+	RestoreCheckpoint(ctx context.Context, req *RestoreCheckpointRequest) (*RestoreCheckpointResponse, error)
+	ListCheckpoints(ctx context.Context, req *ListCheckpointsRequest) (*ListCheckpointsResponse, error)
+	ListAllCheckpoints(ctx context.Context, req *ListCheckpointsRequest) *Pager[Checkpoint]
+}
+
+// AuthAPI is the interface implemented by AuthService
+type AuthAPI interface {
+	Login(ctx context.Context, req *LoginRequest) (*TokenResponse, error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*TokenResponse, error)
+	GetUserInfo(ctx context.Context) (*UserInfo, error)
+	ListAPIKeys(ctx context.Context) ([]APIKeyInfo, error)
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, keyID string) error
+	GetAPIKeyInfo(ctx context.Context, keyID string) (*APIKeyInfo, error)
+	ValidateToken(ctx context.Context, token string) (*UserInfo, error)
+	Logout(ctx context.Context) error
+}
+
+// The concrete services implement their interfaces
+var (
+	_ ProjectsAPI      = (*ProjectsService)(nil)
+	_ VectorsAPI       = (*VectorsService)(nil)
+	_ NamespacesAPI    = (*NamespacesService)(nil)
+	_ MemoryAPI        = (*MemoryService)(nil)
+	_ EmbeddingsAPI    = (*EmbeddingsService)(nil)
+	_ SwarmAPI         = (*AgentSwarmService)(nil)
+	_ OrchestrationAPI = (*AgentOrchestrationService)(nil)
+	_ CoordinationAPI  = (*AgentCoordinationService)(nil)
+	_ LearningAPI      = (*AgentLearningService)(nil)
+	_ StateAPI         = (*AgentStateService)(nil)
+	_ AuthAPI          = (*AuthService)(nil)
+)
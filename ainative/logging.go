@@ -0,0 +1,246 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// redactedValue replaces secrets in log output
+const redactedValue = "[REDACTED]"
+
+// sensitiveHeaders are never logged in clear text
+var sensitiveHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+	"Set-Cookie":    true,
+	"X-Api-Key":     true,
+	"X-Api-Secret":  true,
+}
+
+// sensitiveFields are JSON body fields that are never logged in clear text
+var sensitiveFields = map[string]bool{
+	"password":      true,
+	"api_key":       true,
+	"api_secret":    true,
+	"secret":        true,
+	"key":           true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+}
+
+// loggingMiddleware emits structured events for each request. Start events
+// and request/response bodies are only logged when the logger has debug
+// enabled; bodies and headers are redacted.
+func loggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) error {
+			debug := logger.Enabled(ctx, slog.LevelDebug)
+
+			if debug {
+				attrs := []any{
+					slog.String("method", req.Method),
+					slog.String("path", req.Path),
+					slog.Any("headers", redactHeaders(req.Header)),
+				}
+				if req.Body != nil {
+					attrs = append(attrs, slog.Any("body", redactBody(req.Body)))
+				}
+				logger.DebugContext(ctx, "ainative request started", attrs...)
+			}
+
+			start := time.Now()
+			err := next(ctx, req)
+			duration := time.Since(start)
+
+			attrs := []any{
+				slog.String("method", req.Method),
+				slog.String("path", req.Path),
+				slog.Duration("duration", duration),
+			}
+			if req.Response != nil {
+				attrs = append(attrs,
+					slog.Int("status", req.Response.StatusCode),
+					slog.Int("attempts", req.Response.Attempts),
+				)
+				if requestID := req.Response.Header.Get(HeaderRequestID); requestID != "" {
+					attrs = append(attrs, slog.String("request_id", requestID))
+				}
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+
+				var apiErr *APIError
+				if errors.As(err, &apiErr) && apiErr.IsClientError() {
+					logger.WarnContext(ctx, "ainative request failed", attrs...)
+				} else {
+					logger.ErrorContext(ctx, "ainative request failed", attrs...)
+				}
+				return err
+			}
+
+			if debug && req.Result != nil {
+				attrs = append(attrs, slog.Any("body", redactBody(req.Result)))
+			}
+			logger.InfoContext(ctx, "ainative request finished", attrs...)
+
+			return nil
+		}
+	}
+}
+
+// retryLogHook returns a resty retry hook that logs each retry attempt
+func retryLogHook(logger *slog.Logger) resty.OnRetryFunc {
+	return func(resp *resty.Response, err error) {
+		ctx := context.Background()
+		attrs := []any{}
+
+		if resp != nil && resp.Request != nil {
+			ctx = resp.Request.Context()
+			attrs = append(attrs,
+				slog.String("method", resp.Request.Method),
+				slog.String("url", resp.Request.URL),
+				slog.Int("attempt", resp.Request.Attempt),
+				slog.Int("status", resp.StatusCode()),
+			)
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		logger.WarnContext(ctx, "ainative request retrying", attrs...)
+	}
+}
+
+// restyLogger forwards resty's internal log output to a slog.Logger
+type restyLogger struct {
+	logger *slog.Logger
+}
+
+// Errorf implements resty.Logger
+func (l restyLogger) Errorf(format string, v ...interface{}) {
+	l.logger.Error(strings.TrimSpace(fmt.Sprintf(format, v...)), slog.String("source", "resty"))
+}
+
+// Warnf implements resty.Logger
+func (l restyLogger) Warnf(format string, v ...interface{}) {
+	l.logger.Warn(strings.TrimSpace(fmt.Sprintf(format, v...)), slog.String("source", "resty"))
+}
+
+// Debugf implements resty.Logger
+func (l restyLogger) Debugf(format string, v ...interface{}) {
+	l.logger.Debug(strings.TrimSpace(fmt.Sprintf(format, v...)), slog.String("source", "resty"))
+}
+
+// logRateLimitWait records time spent blocked by the client rate limiter
+func (c *Client) logRateLimitWait(ctx context.Context, req *Request, waited time.Duration) {
+	if c.logger == nil || waited < time.Millisecond {
+		return
+	}
+
+	c.logger.DebugContext(ctx, "ainative rate limit wait",
+		slog.String("method", req.Method),
+		slog.String("path", req.Path),
+		slog.Duration("waited", waited),
+	)
+}
+
+// redactHeaders returns a copy of header with secret values replaced
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for key, values := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			redacted[key] = redactedValue
+			continue
+		}
+		redacted[key] = strings.Join(values, ", ")
+	}
+	return redacted
+}
+
+// redactRequestLog scrubs resty's debug dump of a request before it is
+// written to the logger
+func redactRequestLog(rl *resty.RequestLog) error {
+	redactHeaderValues(rl.Header)
+	rl.Body = redactBodyString(rl.Body)
+	return nil
+}
+
+// redactResponseLog scrubs resty's debug dump of a response before it is
+// written to the logger
+func redactResponseLog(rl *resty.ResponseLog) error {
+	redactHeaderValues(rl.Header)
+	rl.Body = redactBodyString(rl.Body)
+	return nil
+}
+
+// redactHeaderValues replaces secret values in header. Entries are replaced
+// rather than modified, so slices shared with a copied header are untouched.
+func redactHeaderValues(header http.Header) {
+	for key := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			header[key] = []string{redactedValue}
+		}
+	}
+}
+
+// redactBodyString redacts a body formatted by resty. JSON bodies have their
+// sensitive fields replaced; other bodies are returned unchanged.
+func redactBodyString(body string) string {
+	var generic interface{}
+	if err := json.Unmarshal([]byte(body), &generic); err != nil {
+		return body
+	}
+
+	data, err := json.MarshalIndent(redactValue(generic), "", "   ")
+	if err != nil {
+		return redactedValue
+	}
+	return string(data)
+}
+
+// redactBody converts v to its JSON form with sensitive fields replaced
+func redactBody(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return redactedValue
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return redactedValue
+	}
+
+	return redactValue(generic)
+}
+
+// redactValue walks a decoded JSON value and replaces sensitive fields
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if sensitiveFields[strings.ToLower(key)] {
+				value[key] = redactedValue
+				continue
+			}
+			value[key] = redactValue(field)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+		return value
+	default:
+		return value
+	}
+}
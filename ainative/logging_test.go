@@ -0,0 +1,201 @@
+package ainative
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logEntries decodes JSON log lines written by a slog.JSONHandler
+func logEntries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func findEntry(entries []map[string]interface{}, msg string) map[string]interface{} {
+	for _, entry := range entries {
+		if entry["msg"] == msg {
+			return entry
+		}
+	}
+	return nil
+}
+
+func TestLogging_DebugRedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-ID", "req-1")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"access_token": "secret-token", "token_type": "bearer", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+		Logger:  logger,
+		Middleware: []Middleware{func(next RequestHandler) RequestHandler {
+			return func(ctx context.Context, req *Request) error {
+				req.Header.Set("Authorization", "Bearer test-key")
+				return next(ctx, req)
+			}
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = client.Auth.Login(context.Background(), &LoginRequest{Username: "user", Password: "hunter2"})
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.NotContains(t, output, "hunter2")
+	assert.NotContains(t, output, "secret-token")
+	assert.NotContains(t, output, "Bearer test-key")
+
+	entries := logEntries(t, &buf)
+
+	started := findEntry(entries, "ainative request started")
+	require.NotNil(t, started)
+	assert.Equal(t, "DEBUG", started["level"])
+	assert.Equal(t, "POST", started["method"])
+	assert.Equal(t, "/api/v1/auth/login", started["path"])
+	assert.Equal(t, redactedValue, started["body"].(map[string]interface{})["password"])
+	assert.Equal(t, "user", started["body"].(map[string]interface{})["username"])
+	assert.Equal(t, redactedValue, started["headers"].(map[string]interface{})["Authorization"])
+
+	finished := findEntry(entries, "ainative request finished")
+	require.NotNil(t, finished)
+	assert.Equal(t, "INFO", finished["level"])
+	assert.Equal(t, float64(200), finished["status"])
+	assert.Equal(t, "req-1", finished["request_id"])
+	assert.Equal(t, redactedValue, finished["body"].(map[string]interface{})["access_token"])
+}
+
+func TestLogging_DebugDumpRedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=SESSIONCOOKIE")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"access_token": "SECRETTOKEN", "token_type": "bearer"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := NewClient(&Config{
+		APIKey:    "SUPERSECRETKEY",
+		APISecret: "SUPERSECRETSECRET",
+		BaseURL:   server.URL,
+		Logger:    logger,
+		Debug:     true,
+	})
+	require.NoError(t, err)
+
+	_, err = client.Auth.Login(context.Background(), &LoginRequest{Username: "user", Password: "hunter2"})
+	require.NoError(t, err)
+
+	output := buf.String()
+	require.Contains(t, output, "~~~ REQUEST ~~~")
+	assert.Contains(t, output, redactedValue)
+	for _, secret := range []string{"SUPERSECRETKEY", "SUPERSECRETSECRET", "hunter2", "SECRETTOKEN", "SESSIONCOOKIE"} {
+		assert.NotContains(t, output, secret)
+	}
+}
+
+func TestLogging_InfoOmitsBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "mem_1", "content": "private notes"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+		Logger:  logger,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Memory.Create(context.Background(), &CreateMemoryRequest{Content: "private notes"})
+	require.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "private notes")
+
+	entries := logEntries(t, &buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "ainative request finished", entries[0]["msg"])
+	assert.Nil(t, entries[0]["body"])
+}
+
+func TestLogging_RetriesAndErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message": "boom"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	client, err := NewClient(&Config{
+		APIKey:      "test-key",
+		BaseURL:     server.URL,
+		Logger:      logger,
+		RetryConfig: &RetryConfig{MaxRetries: 1, InitialDelay: 5 * time.Millisecond, MaxDelay: 10 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	_, err = client.Health(context.Background())
+	require.Error(t, err)
+
+	entries := logEntries(t, &buf)
+
+	retrying := findEntry(entries, "ainative request retrying")
+	require.NotNil(t, retrying)
+	assert.Equal(t, "WARN", retrying["level"])
+	assert.Equal(t, float64(500), retrying["status"])
+
+	failed := findEntry(entries, "ainative request failed")
+	require.NotNil(t, failed)
+	assert.Equal(t, "ERROR", failed["level"])
+	assert.Equal(t, float64(500), failed["status"])
+	assert.Equal(t, float64(2), failed["attempts"])
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer key:secret")
+	header.Set("X-API-Secret", "s3cr3t")
+	header.Set("X-Tenant-ID", "tenant-1")
+
+	redacted := redactHeaders(header)
+
+	assert.Equal(t, redactedValue, redacted["Authorization"])
+	assert.Equal(t, redactedValue, redacted["X-Api-Secret"])
+	assert.Equal(t, "tenant-1", redacted["X-Tenant-Id"])
+}
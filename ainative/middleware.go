@@ -0,0 +1,57 @@
+package ainative
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request describes a single API call as seen by middleware
+type Request struct {
+	// HTTP method (GET, POST, PUT, DELETE, PATCH)
+	Method string
+
+	// Request path relative to the base URL, including any query string
+	Path string
+
+	// Per-request headers; these take precedence over the client defaults
+	Header http.Header
+
+	// Request body to be JSON encoded (may be nil)
+	Body interface{}
+
+	// Destination for the decoded response body (may be nil)
+	Result interface{}
+
+	// Response is populated once the request has reached the server
+	Response *Response
+}
+
+// Response holds the HTTP-level outcome of a Request
+type Response struct {
+	StatusCode int
+	Header     http.Header
+
+	// Number of HTTP attempts made, including transport-level retries
+	Attempts int
+}
+
+// RequestHandler performs a Request and decodes the response into req.Result.
+// API failures are returned as *APIError.
+type RequestHandler func(ctx context.Context, req *Request) error
+
+// Middleware wraps a RequestHandler. A middleware may mutate the request
+// before calling next, inspect req.Result or the returned error afterwards,
+// short-circuit by not calling next at all, or retry by calling next again.
+type Middleware func(next RequestHandler) RequestHandler
+
+// chainMiddleware composes middleware so that the first entry is the
+// outermost wrapper around final
+func chainMiddleware(final RequestHandler, middleware ...Middleware) RequestHandler {
+	handler := final
+	for i := len(middleware) - 1; i >= 0; i-- {
+		if middleware[i] != nil {
+			handler = middleware[i](handler)
+		}
+	}
+	return handler
+}
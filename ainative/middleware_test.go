@@ -0,0 +1,198 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareOrderAndHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant-42", r.Header.Get("X-Tenant-ID"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer server.Close()
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(ctx context.Context, req *Request) error {
+				calls = append(calls, name+":before")
+				err := next(ctx, req)
+				calls = append(calls, name+":after")
+				return err
+			}
+		}
+	}
+
+	tenant := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) error {
+			req.Header.Set("X-Tenant-ID", "tenant-42")
+			return next(ctx, req)
+		}
+	}
+
+	client, err := NewClient(&Config{
+		APIKey:     "test-key",
+		BaseURL:    server.URL,
+		Middleware: []Middleware{record("outer"), tenant, record("inner")},
+	})
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	err = client.makeRequest(context.Background(), "GET", endpoint("/test"), nil, &result)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", result["status"])
+	assert.Equal(t, []string{"outer:before", "inner:before", "inner:after", "outer:after"}, calls)
+}
+
+func TestMiddlewareSeesResultAndError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "not found", "code": "NOT_FOUND"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "123"}`))
+	}))
+	defer server.Close()
+
+	var seenResult interface{}
+	var seenErr error
+	var seenStatus int
+	audit := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) error {
+			err := next(ctx, req)
+			seenResult = req.Result
+			seenErr = err
+			if req.Response != nil {
+				seenStatus = req.Response.StatusCode
+			}
+			return err
+		}
+	}
+
+	client, err := NewClient(&Config{
+		APIKey:     "test-key",
+		BaseURL:    server.URL,
+		Middleware: []Middleware{audit},
+	})
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	err = client.makeRequest(context.Background(), "GET", endpoint("/found"), nil, &result)
+	require.NoError(t, err)
+	assert.Equal(t, &result, seenResult)
+	assert.Equal(t, "123", result["id"])
+	assert.Equal(t, http.StatusOK, seenStatus)
+
+	err = client.makeRequest(context.Background(), "GET", endpoint("/missing"), nil, nil)
+	require.Error(t, err)
+	apiErr, ok := seenErr.(*APIError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, http.StatusNotFound, seenStatus)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cache := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) error {
+			if res, ok := req.Result.(*map[string]interface{}); ok {
+				*res = map[string]interface{}{"cached": true}
+				return nil
+			}
+			return next(ctx, req)
+		}
+	}
+
+	client, err := NewClient(&Config{
+		APIKey:     "test-key",
+		BaseURL:    server.URL,
+		Middleware: []Middleware{cache},
+	})
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	err = client.makeRequest(context.Background(), "GET", endpoint("/test"), nil, &result)
+
+	assert.NoError(t, err)
+	assert.Equal(t, true, result["cached"])
+	assert.Equal(t, 0, hits)
+}
+
+func TestMiddlewareRetryAndBodyRewrite(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "[REDACTED]", body["email"])
+
+		w.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"message": "conflict"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer server.Close()
+
+	redact := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) error {
+			if body, ok := req.Body.(map[string]interface{}); ok {
+				redacted := make(map[string]interface{}, len(body))
+				for k, v := range body {
+					redacted[k] = v
+				}
+				redacted["email"] = "[REDACTED]"
+				req.Body = redacted
+			}
+			return next(ctx, req)
+		}
+	}
+
+	retryConflict := func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) error {
+			err := next(ctx, req)
+			if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusConflict {
+				return next(ctx, req)
+			}
+			return err
+		}
+	}
+
+	client, err := NewClient(&Config{
+		APIKey:     "test-key",
+		BaseURL:    server.URL,
+		Middleware: []Middleware{retryConflict, redact},
+	})
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	err = client.makeRequest(context.Background(), "POST", endpoint("/test"), map[string]interface{}{"email": "a@b.c"}, &result)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", result["status"])
+	assert.Equal(t, 2, attempts)
+}
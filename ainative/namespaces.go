@@ -0,0 +1,219 @@
+package ainative
+
+import "context"
+
+// NamespacesService handles vector namespace operations. Namespaces partition
+// a project's vectors, e.g. one namespace per tenant.
+type NamespacesService struct {
+	client *Client
+}
+
+// Namespace represents a vector namespace
+type Namespace struct {
+	Name        string `json:"name"`
+	VectorCount int64  `json:"vector_count"`
+	Dimension   int    `json:"dimension"`
+}
+
+// ListNamespacesRequest represents a request to list namespaces
+type ListNamespacesRequest struct {
+	Prefix string `json:"prefix,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+	Offset int    `json:"offset,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListNamespacesResponse represents a page of namespaces
+type ListNamespacesResponse struct {
+	Namespaces []Namespace `json:"namespaces"`
+	TotalCount int         `json:"total_count"`
+	Limit      int         `json:"limit"`
+	Offset     int         `json:"offset"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+}
+
+// CopyNamespaceRequest represents a request to copy a namespace's vectors
+// into another namespace
+type CopyNamespaceRequest struct {
+	Destination string `json:"destination"`
+
+	// Overwrite replaces the vectors of an existing destination instead of
+	// failing with ErrConflict
+	Overwrite bool `json:"overwrite,omitempty"`
+}
+
+// RenameNamespaceRequest represents a request to rename a namespace
+type RenameNamespaceRequest struct {
+	Name string `json:"name"`
+
+	// Overwrite replaces an existing namespace called Name instead of
+	// failing with ErrConflict
+	Overwrite bool `json:"overwrite,omitempty"`
+}
+
+// List lists the namespaces of a project with their vector counts and
+// dimensions
+func (s *NamespacesService) List(ctx context.Context, projectID string, req *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+
+	if req == nil {
+		req = &ListNamespacesRequest{}
+	}
+
+	// Set defaults
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	var result ListNamespacesResponse
+
+	path := endpoint("/api/v1/zerodb/projects/%s/namespaces", projectID).
+		Query("prefix", req.Prefix).
+		QueryInt("limit", req.Limit).
+		QueryInt("offset", req.Offset).
+		Query("cursor", req.Cursor)
+
+	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns a Pager over every namespace matching req, fetching
+// req.Limit namespaces per page
+func (s *NamespacesService) ListAll(ctx context.Context, projectID string, req *ListNamespacesRequest) *Pager[Namespace] {
+	query := ListNamespacesRequest{}
+	if req != nil {
+		query = *req
+	}
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+
+	return newPager(ctx, query.Limit, query.Offset, func(ctx context.Context, cursor string, offset int) (*Page[Namespace], error) {
+		page := query
+		page.Cursor = cursor
+		page.Offset = offset
+
+		result, err := s.List(ctx, projectID, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[Namespace]{Items: result.Namespaces, NextCursor: result.NextCursor, Total: result.TotalCount}, nil
+	})
+}
+
+// Describe returns the vector count and dimension of a namespace
+func (s *NamespacesService) Describe(ctx context.Context, projectID, namespace string) (*Namespace, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+
+	if namespace == "" {
+		return nil, NewValidationError("namespace", "namespace is required", namespace)
+	}
+
+	var result Namespace
+
+	path := endpoint("/api/v1/zerodb/projects/%s/namespaces/%s", projectID, namespace)
+
+	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a namespace and every vector in it
+func (s *NamespacesService) Delete(ctx context.Context, projectID, namespace string) (*DeleteVectorsResponse, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+
+	if namespace == "" {
+		return nil, NewValidationError("namespace", "namespace is required", namespace)
+	}
+
+	var result DeleteVectorsResponse
+
+	path := endpoint("/api/v1/zerodb/projects/%s/namespaces/%s", projectID, namespace)
+
+	err := s.client.makeRequest(ctx, "DELETE", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Copy copies every vector in a namespace into req.Destination and returns
+// the destination namespace
+func (s *NamespacesService) Copy(ctx context.Context, projectID, namespace string, req *CopyNamespaceRequest) (*Namespace, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+
+	if namespace == "" {
+		return nil, NewValidationError("namespace", "namespace is required", namespace)
+	}
+
+	if req == nil {
+		return nil, NewValidationError("request", "request cannot be nil", nil)
+	}
+
+	if req.Destination == "" || req.Destination == namespace {
+		return nil, NewValidationError("destination", "destination must differ from the source namespace", req.Destination)
+	}
+
+	var result Namespace
+
+	path := endpoint("/api/v1/zerodb/projects/%s/namespaces/%s/copy", projectID, namespace)
+
+	err := s.client.makeRequest(ctx, "POST", path, req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Rename renames a namespace and returns it under its new name
+func (s *NamespacesService) Rename(ctx context.Context, projectID, namespace string, req *RenameNamespaceRequest) (*Namespace, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+
+	if namespace == "" {
+		return nil, NewValidationError("namespace", "namespace is required", namespace)
+	}
+
+	if req == nil {
+		return nil, NewValidationError("request", "request cannot be nil", nil)
+	}
+
+	if req.Name == "" || req.Name == namespace {
+		return nil, NewValidationError("name", "name must differ from the current namespace", req.Name)
+	}
+
+	var result Namespace
+
+	path := endpoint("/api/v1/zerodb/projects/%s/namespaces/%s/rename", projectID, namespace)
+
+	err := s.client.makeRequest(ctx, "POST", path, req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
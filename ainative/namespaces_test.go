@@ -0,0 +1,114 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespacesService_ListAndDescribe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.EscapedPath() {
+		case "/api/v1/zerodb/projects/proj_123/namespaces":
+			assert.Equal(t, "tenant-", r.URL.Query().Get("prefix"))
+			assert.Equal(t, "10", r.URL.Query().Get("limit"))
+			json.NewEncoder(w).Encode(ListNamespacesResponse{
+				Namespaces: []Namespace{
+					{Name: "tenant-a", VectorCount: 10, Dimension: 384},
+					{Name: "tenant-b", VectorCount: 5, Dimension: 384},
+				},
+				TotalCount: 2,
+				Limit:      10,
+			})
+		case "/api/v1/zerodb/projects/proj_123/namespaces/tenant%2Fa":
+			json.NewEncoder(w).Encode(Namespace{Name: "tenant/a", VectorCount: 10, Dimension: 384})
+		default:
+			t.Errorf("unexpected path %s", r.URL.EscapedPath())
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	all, err := client.ZeroDB.Namespaces.ListAll(ctx, "proj_123", &ListNamespacesRequest{Prefix: "tenant-"}).Collect()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, int64(10), all[0].VectorCount)
+
+	namespace, err := client.ZeroDB.Namespaces.Describe(ctx, "proj_123", "tenant/a")
+	require.NoError(t, err)
+	assert.Equal(t, 384, namespace.Dimension)
+}
+
+func TestNamespacesService_DeleteCopyRename(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "DELETE /api/v1/zerodb/projects/proj_123/namespaces/tenant-a":
+			json.NewEncoder(w).Encode(DeleteVectorsResponse{DeletedCount: 10, Namespace: "tenant-a"})
+		case "POST /api/v1/zerodb/projects/proj_123/namespaces/tenant-a/copy":
+			var req CopyNamespaceRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "tenant-a-backup", req.Destination)
+			json.NewEncoder(w).Encode(Namespace{Name: req.Destination, VectorCount: 10})
+		case "POST /api/v1/zerodb/projects/proj_123/namespaces/tenant-a/rename":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"detail": "Namespace tenant-b already exists"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	copied, err := client.ZeroDB.Namespaces.Copy(ctx, "proj_123", "tenant-a", &CopyNamespaceRequest{Destination: "tenant-a-backup"})
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a-backup", copied.Name)
+
+	_, err = client.ZeroDB.Namespaces.Rename(ctx, "proj_123", "tenant-a", &RenameNamespaceRequest{Name: "tenant-b"})
+	assert.True(t, errors.Is(err, ErrConflict))
+
+	deleted, err := client.ZeroDB.Namespaces.Delete(ctx, "proj_123", "tenant-a")
+	require.NoError(t, err)
+	assert.Equal(t, 10, deleted.DeletedCount)
+}
+
+func TestNamespacesService_Validation(t *testing.T) {
+	client, err := NewClient(&Config{APIKey: "test-key"})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = client.ZeroDB.Namespaces.List(ctx, "", nil)
+	assert.Contains(t, err.Error(), "project ID is required")
+
+	_, err = client.ZeroDB.Namespaces.Describe(ctx, "proj_123", "")
+	assert.Contains(t, err.Error(), "namespace is required")
+
+	_, err = client.ZeroDB.Namespaces.Delete(ctx, "proj_123", "")
+	assert.Contains(t, err.Error(), "namespace is required")
+
+	_, err = client.ZeroDB.Namespaces.Copy(ctx, "proj_123", "tenant-a", &CopyNamespaceRequest{Destination: "tenant-a"})
+	assert.Contains(t, err.Error(), "destination must differ")
+
+	_, err = client.ZeroDB.Namespaces.Rename(ctx, "proj_123", "tenant-a", nil)
+	assert.Contains(t, err.Error(), "request cannot be nil")
+
+	_, err = client.ZeroDB.Namespaces.Rename(ctx, "proj_123", "tenant-a", &RenameNamespaceRequest{})
+	assert.Contains(t, err.Error(), "name must differ")
+}
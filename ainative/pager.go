@@ -0,0 +1,206 @@
+package ainative
+
+import (
+	"context"
+	"sync"
+)
+
+// Page is a single page of results from a List endpoint
+type Page[T any] struct {
+	Items []T
+
+	// Cursor for the next page, when the server supports cursor pagination
+	NextCursor string
+
+	// Total number of results, if reported by the server
+	Total int
+}
+
+// pageFetcher fetches the page at cursor, or at offset when cursor is empty
+type pageFetcher[T any] func(ctx context.Context, cursor string, offset int) (*Page[T], error)
+
+// pageResult is a fetched page or the error fetching it
+type pageResult[T any] struct {
+	page *Page[T]
+	err  error
+}
+
+// Pager walks every page of a List endpoint. It follows cursor tokens when
+// the server returns them and falls back to limit/offset otherwise.
+//
+// Example:
+//
+//	pager := client.ZeroDB.Projects.ListAll(ctx, nil)
+//	defer pager.Close()
+//	for pager.Next() {
+//	    project := pager.Item()
+//	    // ...
+//	}
+//	if err := pager.Err(); err != nil {
+//	    return err
+//	}
+//
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	fetch  pageFetcher[T]
+	limit  int
+
+	prefetch bool
+	pending  chan pageResult[T]
+
+	items   []T
+	index   int
+	item    T
+	offset  int
+	cursor  string
+	hasMore bool
+	err     error
+
+	closeOnce sync.Once
+}
+
+// newPager creates a pager fetching pages of size limit, starting at offset.
+// Pages may be prefetched concurrently, so a ResponseMeta on ctx is not
+// filled.
+func newPager[T any](ctx context.Context, limit, offset int, fetch pageFetcher[T]) *Pager[T] {
+	ctx, cancel := context.WithCancel(detachCallValues(ctx))
+	return &Pager[T]{
+		ctx:     ctx,
+		cancel:  cancel,
+		fetch:   fetch,
+		limit:   limit,
+		offset:  offset,
+		index:   -1,
+		hasMore: true,
+	}
+}
+
+// NewSlicePager returns a Pager over items, for use in tests and mocks. When
+// err is non-nil, it is returned by Err after every item has been read.
+func NewSlicePager[T any](items []T, err error) *Pager[T] {
+	limit := len(items)
+	if err == nil {
+		// A short page ends the pager
+		limit++
+	}
+
+	return newPager(context.Background(), limit, 0, func(_ context.Context, _ string, offset int) (*Page[T], error) {
+		if offset < len(items) {
+			return &Page[T]{Items: items}, nil
+		}
+		return nil, err
+	})
+}
+
+// WithPrefetch makes the pager fetch the next page in the background while
+// the current one is consumed. It must be called before the first Next.
+func (p *Pager[T]) WithPrefetch() *Pager[T] {
+	p.prefetch = true
+	return p
+}
+
+// Next advances to the next item, fetching pages as needed. It returns false
+// when all items have been read, an error occurred, or the pager was closed.
+func (p *Pager[T]) Next() bool {
+	for p.index+1 >= len(p.items) {
+		if !p.hasMore || !p.advancePage() {
+			p.Close()
+			return false
+		}
+	}
+
+	p.index++
+	p.item = p.items[p.index]
+	return true
+}
+
+// Item returns the current item
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the first error encountered while fetching pages
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Close stops the pager, cancelling any page prefetch in flight. Next returns
+// false after Close.
+func (p *Pager[T]) Close() {
+	p.closeOnce.Do(func() {
+		p.cancel()
+		p.hasMore = false
+		p.items = nil
+		p.index = -1
+	})
+}
+
+// Collect reads every remaining item
+func (p *Pager[T]) Collect() ([]T, error) {
+	defer p.Close()
+
+	var items []T
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
+
+// advancePage loads the next page, reporting whether it succeeded
+func (p *Pager[T]) advancePage() bool {
+	var result pageResult[T]
+	if p.pending != nil {
+		result = <-p.pending
+		p.pending = nil
+	} else {
+		result = p.fetchPage(p.cursor, p.offset)
+	}
+
+	if result.err != nil {
+		p.err = result.err
+		p.hasMore = false
+		return false
+	}
+
+	page := result.page
+	p.items = page.Items
+	p.index = -1
+
+	switch {
+	case page.NextCursor != "":
+		p.cursor = page.NextCursor
+		p.hasMore = true
+	case p.cursor != "":
+		// Cursor pagination ends when no further cursor is returned
+		p.hasMore = false
+	default:
+		p.offset += len(page.Items)
+		if page.Total > 0 {
+			// A reported total is authoritative, so a server capping the
+			// page size below the limit does not end the walk early
+			p.hasMore = len(page.Items) > 0 && p.offset < page.Total
+		} else {
+			p.hasMore = len(page.Items) > 0 && len(page.Items) >= p.limit
+		}
+	}
+
+	if p.hasMore && p.prefetch {
+		p.pending = make(chan pageResult[T], 1)
+		go func(pending chan<- pageResult[T], cursor string, offset int) {
+			pending <- p.fetchPage(cursor, offset)
+		}(p.pending, p.cursor, p.offset)
+	}
+
+	return true
+}
+
+// fetchPage fetches a single page
+func (p *Pager[T]) fetchPage(cursor string, offset int) pageResult[T] {
+	page, err := p.fetch(p.ctx, cursor, offset)
+	if err == nil && page == nil {
+		page = &Page[T]{}
+	}
+	return pageResult[T]{page: page, err: err}
+}
@@ -0,0 +1,32 @@
+//go:build go1.23
+
+package ainative
+
+import "iter"
+
+// All returns an iterator over the remaining items. Breaking out of the loop
+// closes the pager; a fetch error is yielded once as the final element.
+//
+// Example:
+//
+//	for project, err := range client.ZeroDB.Projects.ListAll(ctx, nil).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    // ...
+//	}
+func (p *Pager[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer p.Close()
+
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
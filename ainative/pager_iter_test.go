@@ -0,0 +1,33 @@
+//go:build go1.23
+
+package ainative
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPager_All(t *testing.T) {
+	var requests int32
+	server := newProjectsServer(t, 50, &requests)
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	var ids []string
+	for project, err := range client.ZeroDB.Projects.ListAll(context.Background(), &ListProjectsRequest{Limit: 4}).All() {
+		require.NoError(t, err)
+		ids = append(ids, project.ID)
+		if len(ids) == 6 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"proj_0", "proj_1", "proj_2", "proj_3", "proj_4", "proj_5"}, ids)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
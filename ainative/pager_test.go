@@ -0,0 +1,225 @@
+package ainative
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProjectsServer serves total projects with limit/offset pagination
+func newProjectsServer(t *testing.T, total int, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		assert.Equal(t, "/api/v1/zerodb/projects", r.URL.Path)
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		projects := "["
+		for i := offset; i < offset+limit && i < total; i++ {
+			if i > offset {
+				projects += ","
+			}
+			projects += fmt.Sprintf(`{"id": "proj_%d"}`, i)
+		}
+		projects += "]"
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"projects": %s, "total_count": %d, "limit": %d, "offset": %d}`, projects, total, limit, offset)
+	}))
+}
+
+func TestPager_OffsetPagination(t *testing.T) {
+	var requests int32
+	server := newProjectsServer(t, 7, &requests)
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	projects, err := client.ZeroDB.Projects.ListAll(context.Background(), &ListProjectsRequest{Limit: 3}).Collect()
+	require.NoError(t, err)
+
+	require.Len(t, projects, 7)
+	for i, project := range projects {
+		assert.Equal(t, fmt.Sprintf("proj_%d", i), project.ID)
+	}
+	// Pages of 3, 3 and 1; the short page ends the walk
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestPager_ServerCappedPageSize(t *testing.T) {
+	var requests int32
+	projects := newProjectsServer(t, 5, &requests)
+	defer projects.Close()
+
+	// The server returns at most 2 projects per page whatever the limit
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		query.Set("limit", "2")
+		r.URL.RawQuery = query.Encode()
+		projects.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	items, err := client.ZeroDB.Projects.ListAll(context.Background(), &ListProjectsRequest{Limit: 4}).Collect()
+	require.NoError(t, err)
+	require.Len(t, items, 5)
+	assert.Equal(t, "proj_4", items[4].ID)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestPager_StartOffset(t *testing.T) {
+	var requests int32
+	server := newProjectsServer(t, 5, &requests)
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	projects, err := client.ZeroDB.Projects.ListAll(context.Background(), &ListProjectsRequest{Limit: 2, Offset: 3}).Collect()
+	require.NoError(t, err)
+
+	require.Len(t, projects, 2)
+	assert.Equal(t, "proj_3", projects[0].ID)
+	assert.Equal(t, "proj_4", projects[1].ID)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestPager_CursorPagination(t *testing.T) {
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/agent-orchestration/tasks", r.URL.Path)
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch cursor {
+		case "":
+			w.Write([]byte(`{"tasks": [{"id": "task_1"}, {"id": "task_2"}], "next_cursor": "c2"}`))
+		case "c2":
+			w.Write([]byte(`{"tasks": [{"id": "task_3"}, {"id": "task_4"}], "next_cursor": "c3"}`))
+		default:
+			w.Write([]byte(`{"tasks": [{"id": "task_5"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	tasks, err := client.AgentOrchestration.ListAllTasks(context.Background(), &ListTasksRequest{Limit: 2}).Collect()
+	require.NoError(t, err)
+
+	require.Len(t, tasks, 5)
+	assert.Equal(t, "task_5", tasks[4].ID)
+	assert.Equal(t, []string{"", "c2", "c3"}, cursors)
+}
+
+func TestPager_EarlyTermination(t *testing.T) {
+	var requests int32
+	server := newProjectsServer(t, 100, &requests)
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	pager := client.ZeroDB.Projects.ListAll(context.Background(), &ListProjectsRequest{Limit: 5})
+
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, pager.Item().ID)
+		if len(ids) == 7 {
+			break
+		}
+	}
+	pager.Close()
+
+	assert.Len(t, ids, 7)
+	assert.False(t, pager.Next())
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestPager_Prefetch(t *testing.T) {
+	var requests int32
+	server := newProjectsServer(t, 4, &requests)
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	pager := client.ZeroDB.Projects.ListAll(context.Background(), &ListProjectsRequest{Limit: 2}).WithPrefetch()
+	defer pager.Close()
+
+	require.True(t, pager.Next())
+	assert.Equal(t, "proj_0", pager.Item().ID)
+
+	// The second page is requested while the first is being consumed
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&requests) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, pager.Item().ID)
+	}
+	require.NoError(t, pager.Err())
+	assert.Equal(t, []string{"proj_1", "proj_2", "proj_3"}, ids)
+}
+
+func TestPager_Error(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls > 1 {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message": "forbidden"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"checkpoints": [{"id": "cp_1"}, {"id": "cp_2"}], "total_count": 10}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	checkpoints, err := client.AgentState.ListAllCheckpoints(context.Background(), &ListCheckpointsRequest{Limit: 2}).Collect()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Len(t, checkpoints, 2)
+}
+
+func TestNewSlicePager(t *testing.T) {
+	items, err := NewSlicePager([]int{1, 2, 3}, nil).Collect()
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+
+	items, err = NewSlicePager[int](nil, nil).Collect()
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	boom := fmt.Errorf("boom")
+	items, err = NewSlicePager([]int{1, 2}, boom).Collect()
+	assert.Equal(t, boom, err)
+	assert.Equal(t, []int{1, 2}, items)
+
+	items, err = NewSlicePager[int](nil, boom).Collect()
+	assert.Equal(t, boom, err)
+	assert.Empty(t, items)
+}
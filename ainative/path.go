@@ -0,0 +1,84 @@
+package ainative
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// requestPath builds a request path with escaped path segments and query
+// parameters. Services use it instead of formatting URLs by hand so that IDs
+// containing characters such as '/', '&', '#' or spaces cannot corrupt the
+// request.
+type requestPath struct {
+	format string
+	path   string
+	query  url.Values
+}
+
+// endpoint creates a requestPath from format, filling each %s verb with the
+// corresponding path-escaped segment
+//
+// Example:
+//
+//	endpoint("/api/v1/zerodb/projects/%s/vectors", projectID).QueryInt("limit", 10)
+func endpoint(format string, segments ...string) *requestPath {
+	args := make([]interface{}, len(segments))
+	for i, segment := range segments {
+		args[i] = url.PathEscape(segment)
+	}
+
+	return &requestPath{
+		format: format,
+		path:   fmt.Sprintf(format, args...),
+		query:  url.Values{},
+	}
+}
+
+// Query sets a query parameter, skipping empty values
+func (p *requestPath) Query(key, value string) *requestPath {
+	if value != "" {
+		p.query.Set(key, value)
+	}
+	return p
+}
+
+// QueryInt sets an integer query parameter
+func (p *requestPath) QueryInt(key string, value int) *requestPath {
+	p.query.Set(key, strconv.Itoa(value))
+	return p
+}
+
+// QueryPositiveInt sets an integer query parameter, skipping values <= 0
+func (p *requestPath) QueryPositiveInt(key string, value int) *requestPath {
+	if value > 0 {
+		p.QueryInt(key, value)
+	}
+	return p
+}
+
+// QueryValues adds a repeated query parameter, one entry per non-empty value
+func (p *requestPath) QueryValues(key string, values []string) *requestPath {
+	for _, value := range values {
+		if value != "" {
+			p.query.Add(key, value)
+		}
+	}
+	return p
+}
+
+// Route returns the path template with every segment verb replaced by {id},
+// e.g. "/api/v1/zerodb/projects/{id}", so that span names and metric
+// attributes have bounded cardinality
+func (p *requestPath) Route() string {
+	return strings.ReplaceAll(p.format, "%s", "{id}")
+}
+
+// String returns the path with its encoded query string
+func (p *requestPath) String() string {
+	if len(p.query) == 0 {
+		return p.path
+	}
+	return p.path + "?" + p.query.Encode()
+}
@@ -0,0 +1,130 @@
+package ainative
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		path *requestPath
+		want string
+	}{
+		{
+			name: "plain segment",
+			path: endpoint("/api/v1/zerodb/projects/%s", "proj_123"),
+			want: "/api/v1/zerodb/projects/proj_123",
+		},
+		{
+			name: "escaped segment",
+			path: endpoint("/api/v1/agent-swarm/swarms/%s/stop", "a/b c#d?e"),
+			want: "/api/v1/agent-swarm/swarms/a%2Fb%20c%23d%3Fe/stop",
+		},
+		{
+			name: "query parameters",
+			path: endpoint("/api/v1/agent-orchestration/tasks").
+				QueryInt("limit", 10).
+				QueryInt("offset", 0).
+				Query("agent_id", "agent&1 #2").
+				Query("status", ""),
+			want: "/api/v1/agent-orchestration/tasks?agent_id=agent%261+%232&limit=10&offset=0",
+		},
+		{
+			name: "repeated parameters",
+			path: endpoint("/api/v1/agent-coordination/workload").
+				QueryValues("agent_ids", []string{"a", "", "b"}),
+			want: "/api/v1/agent-coordination/workload?agent_ids=a&agent_ids=b",
+		},
+		{
+			name: "positive int",
+			path: endpoint("/api/v1/agent-state/state").
+				QueryPositiveInt("version", 0),
+			want: "/api/v1/agent-state/state",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.path.String())
+		})
+	}
+}
+
+func TestEndpoint_EscapingReachesServer(t *testing.T) {
+	var escapedPath string
+	var agentID, period string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		escapedPath = r.URL.EscapedPath()
+		agentID = r.URL.Query().Get("agent_id")
+		period = r.URL.Query().Get("period")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = client.AgentLearning.GetPerformanceMetrics(ctx, &GetPerformanceMetricsRequest{
+		AgentID: "agent&period=evil #1",
+		Period:  "7d",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "agent&period=evil #1", agentID)
+	assert.Equal(t, "7d", period)
+
+	_, err = client.ZeroDB.Projects.Get(ctx, "team/proj 1")
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/zerodb/projects/team%2Fproj%201", escapedPath)
+}
+
+func TestGetWorkloadStats_AgentIDs(t *testing.T) {
+	var agentIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/agent-coordination/workload", r.URL.Path)
+		agentIDs = r.URL.Query()["agent_ids"]
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"workloads": [], "total_tasks": 0}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	_, err = client.AgentCoordination.GetWorkloadStats(context.Background(), &GetWorkloadStatsRequest{
+		AgentIDs: []string{"agent_1", "agent 2"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"agent_1", "agent 2"}, agentIDs)
+}
+
+func TestEndpointRoute(t *testing.T) {
+	tests := map[string]*requestPath{
+		"/api/v1/zerodb/projects/{id}":                       endpoint("/api/v1/zerodb/projects/%s", "proj_123"),
+		"/api/v1/zerodb/projects/{id}/vectors/search":        endpoint("/api/v1/zerodb/projects/%s/vectors/search", "search"),
+		"/api/v1/zerodb/projects":                            endpoint("/api/v1/zerodb/projects").QueryInt("limit", 10),
+		"/api/v1/zerodb/projects/{id}/vectors/{id}/metadata": endpoint("/api/v1/zerodb/projects/%s/vectors/%s/metadata", "p", "a/b"),
+		"/health": endpoint("/health"),
+	}
+
+	for want, path := range tests {
+		assert.Equal(t, want, path.Route(), path.String())
+	}
+}
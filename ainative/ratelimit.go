@@ -0,0 +1,164 @@
+package ainative
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Rate limit response headers understood by the client
+const (
+	HeaderRetryAfter         = "Retry-After"
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// adaptiveLimiter is a token bucket limiter that starts at the configured
+// rate and slows down based on the server's rate limit headers. Once the
+// server's reset time has passed it returns to the configured rate.
+type adaptiveLimiter struct {
+	mu      sync.Mutex
+	limiter *rate.Limiter
+	limit   rate.Limit
+	burst   int
+	resetAt time.Time
+	now     func() time.Time
+}
+
+// newAdaptiveLimiter creates a limiter allowing requestsPerSecond with an equal burst
+func newAdaptiveLimiter(requestsPerSecond int) *adaptiveLimiter {
+	return &adaptiveLimiter{
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond),
+		limit:   rate.Limit(requestsPerSecond),
+		burst:   requestsPerSecond,
+		now:     time.Now,
+	}
+}
+
+// Wait blocks until the limiter permits a request or ctx is done
+func (l *adaptiveLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	if !l.resetAt.IsZero() && !l.now().Before(l.resetAt) {
+		l.restoreLocked()
+	}
+	l.mu.Unlock()
+
+	return l.limiter.Wait(ctx)
+}
+
+// Observe adjusts the limiter from the rate limit headers of a response
+func (l *adaptiveLimiter) Observe(statusCode int, header http.Header) {
+	now := l.now()
+
+	if statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable {
+		if wait, ok := parseRetryAfter(header, now); ok {
+			l.pauseUntil(now, now.Add(wait))
+			return
+		}
+	}
+
+	remaining, err := strconv.Atoi(header.Get(HeaderRateLimitRemaining))
+	if err != nil || remaining < 0 {
+		return
+	}
+
+	resetAt, ok := parseRateLimitReset(header, now)
+	if !ok || !resetAt.After(now) {
+		return
+	}
+
+	if remaining == 0 {
+		l.pauseUntil(now, resetAt)
+		return
+	}
+
+	// Spread the remaining quota evenly over the rest of the window
+	window := resetAt.Sub(now).Seconds()
+	limit := rate.Limit(float64(remaining) / window)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit > l.limit {
+		limit = l.limit
+	}
+	burst := remaining
+	if burst > l.burst {
+		burst = l.burst
+	}
+
+	l.limiter.SetLimitAt(now, limit)
+	l.limiter.SetBurstAt(now, burst)
+	l.resetAt = resetAt
+}
+
+// pauseUntil holds back requests until resetAt
+func (l *adaptiveLimiter) pauseUntil(now, resetAt time.Time) {
+	if !resetAt.After(now) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// A single token that refills at resetAt; drain it so the next Wait blocks
+	l.limiter.SetLimitAt(now, rate.Every(resetAt.Sub(now)))
+	l.limiter.SetBurstAt(now, 1)
+	l.limiter.AllowN(now, 1)
+	l.resetAt = resetAt
+}
+
+// restoreLocked returns the limiter to the configured rate
+func (l *adaptiveLimiter) restoreLocked() {
+	now := l.now()
+	l.limiter.SetLimitAt(now, l.limit)
+	l.limiter.SetBurstAt(now, l.burst)
+	l.resetAt = time.Time{}
+}
+
+// parseRetryAfter reads a Retry-After header given either as delay seconds
+// or as an HTTP date
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get(HeaderRetryAfter)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// parseRateLimitReset reads X-RateLimit-Reset, which servers send either as a
+// Unix timestamp or as seconds until the window resets
+func parseRateLimitReset(header http.Header, now time.Time) (time.Time, bool) {
+	value, err := strconv.ParseFloat(header.Get(HeaderRateLimitReset), 64)
+	if err != nil || value < 0 {
+		return time.Time{}, false
+	}
+
+	// Anything this large can only be an epoch timestamp
+	if value > 1e9 {
+		return time.Unix(0, int64(value*float64(time.Second))), true
+	}
+
+	return now.Add(time.Duration(value * float64(time.Second))), true
+}
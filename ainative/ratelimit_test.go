@@ -0,0 +1,190 @@
+package ainative
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "missing", value: "", wantOK: false},
+		{name: "seconds", value: "7", want: 7 * time.Second, wantOK: true},
+		{name: "negative", value: "-1", wantOK: false},
+		{name: "http date", value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second, wantOK: true},
+		{name: "past date", value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0, wantOK: true},
+		{name: "garbage", value: "soon", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.value != "" {
+				header.Set(HeaderRetryAfter, tt.value)
+			}
+
+			got, ok := parseRetryAfter(header, now)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	header := http.Header{}
+	header.Set(HeaderRateLimitReset, "30")
+	resetAt, ok := parseRateLimitReset(header, now)
+	require.True(t, ok)
+	assert.Equal(t, now.Add(30*time.Second), resetAt)
+
+	header.Set(HeaderRateLimitReset, strconv.FormatInt(now.Add(time.Minute).Unix(), 10))
+	resetAt, ok = parseRateLimitReset(header, now)
+	require.True(t, ok)
+	assert.True(t, resetAt.Equal(now.Add(time.Minute)))
+
+	header.Set(HeaderRateLimitReset, "never")
+	_, ok = parseRateLimitReset(header, now)
+	assert.False(t, ok)
+}
+
+func TestAdaptiveLimiter_SlowsDownFromHeaders(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newAdaptiveLimiter(100)
+	limiter.now = func() time.Time { return now }
+
+	header := http.Header{}
+	header.Set(HeaderRateLimitRemaining, "10")
+	header.Set(HeaderRateLimitReset, "5")
+	limiter.Observe(http.StatusOK, header)
+
+	assert.Equal(t, rate.Limit(2), limiter.limiter.Limit())
+	assert.Equal(t, 10, limiter.limiter.Burst())
+
+	// Plenty of quota never raises the rate above the configured limit
+	header.Set(HeaderRateLimitRemaining, "100000")
+	limiter.Observe(http.StatusOK, header)
+	assert.Equal(t, rate.Limit(100), limiter.limiter.Limit())
+	assert.Equal(t, 100, limiter.limiter.Burst())
+}
+
+func TestAdaptiveLimiter_PausesWhenExhausted(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newAdaptiveLimiter(100)
+	limiter.now = func() time.Time { return now }
+
+	header := http.Header{}
+	header.Set(HeaderRateLimitRemaining, "0")
+	header.Set(HeaderRateLimitReset, "10")
+	limiter.Observe(http.StatusOK, header)
+
+	assert.False(t, limiter.limiter.AllowN(now, 1))
+	assert.Equal(t, now.Add(10*time.Second), limiter.resetAt)
+
+	// After the reset time the configured rate is restored
+	now = now.Add(11 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, limiter.Wait(ctx))
+	assert.Equal(t, rate.Limit(100), limiter.limiter.Limit())
+	assert.True(t, limiter.resetAt.IsZero())
+}
+
+func TestAdaptiveLimiter_RetryAfterOn429(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newAdaptiveLimiter(100)
+	limiter.now = func() time.Time { return now }
+
+	header := http.Header{}
+	header.Set(HeaderRetryAfter, "3")
+	limiter.Observe(http.StatusTooManyRequests, header)
+
+	assert.Equal(t, now.Add(3*time.Second), limiter.resetAt)
+	assert.False(t, limiter.limiter.AllowN(now, 1))
+}
+
+func TestClientHonorsRetryAfter(t *testing.T) {
+	var attempts []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts = append(attempts, time.Now())
+		if len(attempts) == 1 {
+			w.Header().Set(HeaderRetryAfter, "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+		RetryConfig: &RetryConfig{
+			MaxRetries:   2,
+			InitialDelay: 10 * time.Millisecond,
+			MaxDelay:     5 * time.Second,
+		},
+	})
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	err = client.makeRequest(context.Background(), "GET", endpoint("/test"), nil, &result)
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	assert.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), 900*time.Millisecond)
+}
+
+func TestClientRetryAfterLongerThanMaxDelay(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(HeaderRetryAfter, "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message": "slow down"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+		RetryConfig: &RetryConfig{
+			MaxRetries:   3,
+			InitialDelay: 10 * time.Millisecond,
+			MaxDelay:     50 * time.Millisecond,
+		},
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = client.makeRequest(context.Background(), "GET", endpoint("/test"), nil, nil)
+
+	// The 429 is returned at once rather than retried before the server's
+	// Retry-After has passed
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	assert.Equal(t, "slow down", apiErr.Message)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+	assert.Less(t, time.Since(start), time.Second)
+}
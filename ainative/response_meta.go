@@ -0,0 +1,102 @@
+package ainative
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ResponseMeta holds transport details of an API call. Pass a pointer to
+// WithResponseMeta and it is filled in once the call completes.
+//
+// A ResponseMeta describes a single call and is written without
+// synchronization, so a context carrying one must not be shared by calls
+// running concurrently. Helpers that issue several requests, such as ListAll
+// pagers and BulkUpsert, do not fill it.
+type ResponseMeta struct {
+	// Server-assigned request ID, useful for support tickets
+	RequestID string
+
+	// HTTP status code of the final attempt
+	StatusCode int
+
+	// Response headers of the final attempt
+	Header http.Header
+
+	// Rate limit state reported by the server (nil if not reported)
+	RateLimit *RateLimitInfo
+
+	// Raw Server-Timing header
+	ServerTiming string
+
+	// Total time spent in the call, including retries and rate limit waits
+	Latency time.Duration
+
+	// Number of retries performed before the final attempt
+	RetryCount int
+}
+
+// RateLimitInfo represents the X-RateLimit-* response headers
+type RateLimitInfo struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+type responseMetaContextKey struct{}
+
+// WithResponseMeta returns a context that captures response metadata of the
+// call it is passed to into meta. Fields not reported by that call, including
+// all response fields when it fails before a response arrives, are zeroed.
+//
+// Example:
+//
+//	var meta ainative.ResponseMeta
+//	project, err := client.ZeroDB.Projects.Get(ainative.WithResponseMeta(ctx, &meta), id)
+//	log.Printf("request_id=%s latency=%s", meta.RequestID, meta.Latency)
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaContextKey{}, meta)
+}
+
+// responseMetaFromContext returns the ResponseMeta registered on ctx, if any
+func responseMetaFromContext(ctx context.Context) *ResponseMeta {
+	meta, _ := ctx.Value(responseMetaContextKey{}).(*ResponseMeta)
+	return meta
+}
+
+// fill populates meta from the response of a completed request, replacing
+// anything left from an earlier call
+func (meta *ResponseMeta) fill(resp *Response, latency time.Duration) {
+	*meta = ResponseMeta{Latency: latency}
+	if resp == nil {
+		return
+	}
+
+	meta.StatusCode = resp.StatusCode
+	meta.Header = resp.Header
+	meta.RequestID = resp.Header.Get(HeaderRequestID)
+	meta.ServerTiming = resp.Header.Get("Server-Timing")
+	meta.RateLimit = parseRateLimitInfo(resp.Header, time.Now())
+	if resp.Attempts > 1 {
+		meta.RetryCount = resp.Attempts - 1
+	}
+}
+
+// parseRateLimitInfo reads the X-RateLimit-* headers, returning nil if absent
+func parseRateLimitInfo(header http.Header, now time.Time) *RateLimitInfo {
+	limit, limitErr := strconv.Atoi(header.Get(HeaderRateLimitLimit))
+	remaining, remainingErr := strconv.Atoi(header.Get(HeaderRateLimitRemaining))
+	if limitErr != nil && remainingErr != nil {
+		return nil
+	}
+
+	info := &RateLimitInfo{
+		Limit:     limit,
+		Remaining: remaining,
+	}
+	if resetAt, ok := parseRateLimitReset(header, now); ok {
+		info.Reset = resetAt
+	}
+	return info
+}
@@ -0,0 +1,97 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResponseMeta(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-ID", "req-abc")
+		w.Header().Set("Server-Timing", "db;dur=53")
+		w.Header().Set(HeaderRateLimitLimit, "100")
+		w.Header().Set(HeaderRateLimitRemaining, "99")
+		w.Header().Set(HeaderRateLimitReset, "60")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Project{ID: "proj_123"})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:      "test-key",
+		BaseURL:     server.URL,
+		RetryConfig: &RetryConfig{MaxRetries: 2, InitialDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	var meta ResponseMeta
+	project, err := client.ZeroDB.Projects.Get(WithResponseMeta(context.Background(), &meta), "proj_123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "proj_123", project.ID)
+	assert.Equal(t, "req-abc", meta.RequestID)
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+	assert.Equal(t, "db;dur=53", meta.ServerTiming)
+	assert.Equal(t, 1, meta.RetryCount)
+	assert.Greater(t, meta.Latency, time.Duration(0))
+	require.NotNil(t, meta.RateLimit)
+	assert.Equal(t, 100, meta.RateLimit.Limit)
+	assert.Equal(t, 99, meta.RateLimit.Remaining)
+	assert.False(t, meta.RateLimit.Reset.IsZero())
+	assert.Equal(t, "application/json", meta.Header.Get("Content-Type"))
+}
+
+func TestWithResponseMeta_NoRateLimitHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "healthy"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	var meta ResponseMeta
+	_, err = client.Health(WithResponseMeta(context.Background(), &meta))
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+	assert.Equal(t, 0, meta.RetryCount)
+	assert.Nil(t, meta.RateLimit)
+	assert.Empty(t, meta.RequestID)
+}
+
+func TestResponseMeta_FillResetsPreviousCall(t *testing.T) {
+	meta := ResponseMeta{
+		RequestID:  "req-old",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"X-Request-Id": {"req-old"}},
+		RateLimit:  &RateLimitInfo{Limit: 100},
+		RetryCount: 2,
+	}
+
+	meta.fill(&Response{StatusCode: http.StatusAccepted, Header: http.Header{}, Attempts: 1}, time.Second)
+	assert.Equal(t, ResponseMeta{StatusCode: http.StatusAccepted, Header: http.Header{}, Latency: time.Second}, meta)
+
+	// A call that fails before a response arrives leaves only the latency
+	meta.fill(nil, 2*time.Second)
+	assert.Equal(t, ResponseMeta{Latency: 2 * time.Second}, meta)
+}
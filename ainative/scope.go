@@ -0,0 +1,68 @@
+package ainative
+
+import "sync"
+
+// Scope headers sent with every request of a scoped client
+const (
+	HeaderProjectID      = "X-Project-ID"
+	HeaderOrganizationID = "X-Organization-ID"
+)
+
+// WithProject returns a client scoped to projectID. The derived client shares
+// the connection pool, rate limiter, circuit breaker, middleware and
+// credentials of c, but sends its own X-Project-ID header, and ZeroDB methods
+// called with an empty project ID use projectID. Projects.Suspend and
+// Projects.Delete are the exception and always require an explicit ID. c is
+// not modified, so scoped clients can be created per tenant and used
+// concurrently.
+//
+// Example:
+//
+//	tenant := client.WithOrganization(orgID).WithProject(projectID)
+//	results, err := tenant.ZeroDB.Vectors.Search(ctx, "", req)
+func (c *Client) WithProject(projectID string) *Client {
+	config := c.copyConfig()
+	config.ProjectID = projectID
+	return c.derive(&config)
+}
+
+// WithOrganization returns a client scoped to orgID. Like WithProject, the
+// derived client shares all resources with c and leaves c unchanged.
+func (c *Client) WithOrganization(orgID string) *Client {
+	config := c.copyConfig()
+	config.OrganizationID = orgID
+	return c.derive(&config)
+}
+
+// derive creates a client using config that shares c's transport, limiter
+// and request handler
+func (c *Client) derive(config *Config) *Client {
+	derived := *c
+	derived.config = config
+	derived.scopeMu = &sync.RWMutex{}
+	derived.initServices()
+	return &derived
+}
+
+// projectID returns projectID, or the client's scoped project when it is empty
+func (c *Client) projectID(projectID string) string {
+	if projectID != "" {
+		return projectID
+	}
+	projectID, _ = c.scope()
+	return projectID
+}
+
+// scope returns the project and organization the client is scoped to
+func (c *Client) scope() (projectID, orgID string) {
+	c.scopeMu.RLock()
+	defer c.scopeMu.RUnlock()
+	return c.config.ProjectID, c.config.OrganizationID
+}
+
+// copyConfig returns a copy of the client's config
+func (c *Client) copyConfig() Config {
+	c.scopeMu.RLock()
+	defer c.scopeMu.RUnlock()
+	return *c.config
+}
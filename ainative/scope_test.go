@@ -0,0 +1,133 @@
+package ainative
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithProject(t *testing.T) {
+	var mu sync.Mutex
+	seen := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.URL.Path] = r.Header.Get(HeaderProjectID) + "|" + r.Header.Get(HeaderOrganizationID)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"matches": [], "namespace": "default"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:         "test-key",
+		BaseURL:        server.URL,
+		OrganizationID: "org_base",
+	})
+	require.NoError(t, err)
+
+	tenantA := client.WithProject("proj_a")
+	tenantB := client.WithOrganization("org_b").WithProject("proj_b")
+
+	req := func() *VectorSearchRequest {
+		return &VectorSearchRequest{Vector: []float64{0.1, 0.2}, TopK: 1}
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range []*Client{tenantA, tenantB} {
+		wg.Add(1)
+		go func(c *Client) {
+			defer wg.Done()
+			_, err := c.ZeroDB.Vectors.Search(context.Background(), "", req())
+			assert.NoError(t, err)
+		}(c)
+	}
+	wg.Wait()
+
+	assert.Equal(t, "proj_a|org_base", seen["/api/v1/zerodb/projects/proj_a/vectors/search"])
+	assert.Equal(t, "proj_b|org_b", seen["/api/v1/zerodb/projects/proj_b/vectors/search"])
+
+	// An explicit project ID wins over the scope
+	_, err = tenantA.ZeroDB.Vectors.Search(context.Background(), "proj_c", req())
+	require.NoError(t, err)
+	assert.Equal(t, "proj_a|org_base", seen["/api/v1/zerodb/projects/proj_c/vectors/search"])
+
+	// The parent client is unchanged
+	assert.Equal(t, "", client.GetConfig().ProjectID)
+	assert.Equal(t, "org_base", client.GetConfig().OrganizationID)
+	_, err = client.ZeroDB.Vectors.Search(context.Background(), "", req())
+	require.Error(t, err)
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestClient_WithProjectSharesResources(t *testing.T) {
+	client, err := NewClient(&Config{
+		APIKey:         "test-key",
+		CircuitBreaker: &CircuitBreakerConfig{},
+	})
+	require.NoError(t, err)
+
+	scoped := client.WithProject("proj_123")
+
+	assert.Same(t, client.httpClient, scoped.httpClient)
+	assert.Same(t, client.rateLimiter, scoped.rateLimiter)
+	assert.Same(t, client.breaker, scoped.breaker)
+	assert.Same(t, scoped, scoped.ZeroDB.client)
+	assert.Same(t, scoped, scoped.AgentSwarm.client)
+	assert.Same(t, client, client.ZeroDB.client)
+}
+
+func TestClient_WithProjectDestructiveCallsNeedExplicitID(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+	scoped := client.WithProject("proj_a")
+
+	var validationErr *ValidationError
+	err = scoped.ZeroDB.Projects.Delete(context.Background(), "")
+	assert.ErrorAs(t, err, &validationErr)
+	err = scoped.ZeroDB.Projects.Suspend(context.Background(), "", "billing")
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, 0, calls)
+
+	require.NoError(t, scoped.ZeroDB.Projects.Delete(context.Background(), "proj_a"))
+	assert.Equal(t, 1, calls)
+}
+
+func TestClient_SetProjectIDDoesNotAffectScopedClients(t *testing.T) {
+	client, err := NewClient(&Config{APIKey: "test-key"})
+	require.NoError(t, err)
+	scoped := client.WithProject("proj_a")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.SetProjectID("proj_b")
+			client.SetOrganizationID("org_b")
+		}()
+		go func() {
+			defer wg.Done()
+			client.WithProject("proj_c")
+			client.projectID("")
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, "proj_b", client.projectID(""))
+	assert.Equal(t, "proj_a", scoped.projectID(""))
+	assert.Equal(t, "", scoped.GetConfig().OrganizationID)
+}
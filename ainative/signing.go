@@ -0,0 +1,288 @@
+package ainative
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Request signature headers, sent when Config.SignRequests is set
+const (
+	HeaderSignatureTimestamp = "X-AINative-Timestamp"
+	HeaderSignatureNonce     = "X-AINative-Nonce"
+	HeaderSignature          = "X-AINative-Signature"
+)
+
+// signatureVersion prefixes the hex signature in HeaderSignature
+const signatureVersion = "v1"
+
+// DefaultSignatureTolerance is how far a signed request's timestamp may be
+// from the verifier's clock
+const DefaultSignatureTolerance = 5 * time.Minute
+
+// ErrInvalidSignature is returned when a signed request fails verification
+var ErrInvalidSignature = errors.New("ainative: invalid request signature")
+
+// SignRequest signs req with secret. The HMAC-SHA256 signature covers the
+// method, request URI, a timestamp, a random nonce and the SHA-256 of the
+// body, and is sent with the timestamp and nonce in the X-AINative-*
+// headers.
+//
+// Clients created with Config.SignRequests sign every request with
+// Config.APISecret, including each retry, so the secret itself is never sent.
+func SignRequest(req *http.Request, secret string) error {
+	body, err := requestBody(req)
+	if err != nil {
+		return fmt.Errorf("failed to read request body for signing: %w", err)
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate request nonce: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	nonceHex := hex.EncodeToString(nonce)
+
+	req.Header.Set(HeaderSignatureTimestamp, timestamp)
+	req.Header.Set(HeaderSignatureNonce, nonceHex)
+	req.Header.Set(HeaderSignature, signatureVersion+"="+computeSignature(secret, req.Method, req.URL.RequestURI(), timestamp, nonceHex, body))
+	return nil
+}
+
+// VerifyRequest checks the signature of r against secret and that its
+// timestamp is within tolerance of now (DefaultSignatureTolerance when
+// tolerance is zero). The body of r is restored after it is read.
+//
+// VerifyRequest does not detect replays within the tolerance window; use a
+// RequestVerifier for that.
+func VerifyRequest(r *http.Request, secret string, tolerance time.Duration) error {
+	_, err := verifyRequest(r, secret, tolerance, time.Now())
+	return err
+}
+
+// verifyRequest verifies r and returns the time its signature expires
+func verifyRequest(r *http.Request, secret string, tolerance time.Duration, now time.Time) (time.Time, error) {
+	if tolerance <= 0 {
+		tolerance = DefaultSignatureTolerance
+	}
+
+	timestamp := r.Header.Get(HeaderSignatureTimestamp)
+	nonce := r.Header.Get(HeaderSignatureNonce)
+	signature := r.Header.Get(HeaderSignature)
+	if timestamp == "" || nonce == "" || signature == "" {
+		return time.Time{}, fmt.Errorf("%w: missing signature headers", ErrInvalidSignature)
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: malformed timestamp", ErrInvalidSignature)
+	}
+	signedAt := time.Unix(seconds, 0)
+	if skew := now.Sub(signedAt); skew > tolerance || skew < -tolerance {
+		return time.Time{}, fmt.Errorf("%w: timestamp outside tolerance", ErrInvalidSignature)
+	}
+
+	version, provided, ok := strings.Cut(signature, "=")
+	if !ok || version != signatureVersion {
+		return time.Time{}, fmt.Errorf("%w: unsupported signature version", ErrInvalidSignature)
+	}
+
+	body, err := requestBody(r)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: failed to read body: %v", ErrInvalidSignature, err)
+	}
+
+	expected := computeSignature(secret, r.Method, r.URL.RequestURI(), timestamp, nonce, body)
+	if !hmac.Equal([]byte(provided), []byte(expected)) {
+		return time.Time{}, fmt.Errorf("%w: signature mismatch", ErrInvalidSignature)
+	}
+
+	return signedAt.Add(tolerance), nil
+}
+
+// RequestVerifier verifies signed requests and rejects replayed nonces. It is
+// safe for concurrent use.
+//
+// Example:
+//
+//	verifier := ainative.NewRequestVerifier(func(r *http.Request) (string, error) {
+//	    return secretForKey(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+//	})
+//	http.Handle("/", verifier.Handler(mux))
+type RequestVerifier struct {
+	secret    func(r *http.Request) (string, error)
+	tolerance time.Duration
+	now       func() time.Time
+
+	// Seen nonces, bucketed by when their signatures expire so expired
+	// nonces are dropped a bucket at a time
+	mu     sync.Mutex
+	nonces map[int64]map[string]struct{}
+}
+
+// nonceBuckets is the number of buckets a tolerance window is split into
+const nonceBuckets = 8
+
+// NewRequestVerifier creates a verifier that looks up the secret for each
+// request with secret, e.g. by the API key in its Authorization header
+func NewRequestVerifier(secret func(r *http.Request) (string, error)) *RequestVerifier {
+	return &RequestVerifier{
+		secret:    secret,
+		tolerance: DefaultSignatureTolerance,
+		now:       time.Now,
+		nonces:    make(map[int64]map[string]struct{}),
+	}
+}
+
+// WithTolerance sets how far a request's timestamp may be from the
+// verifier's clock; nonces are remembered for the same duration
+func (v *RequestVerifier) WithTolerance(tolerance time.Duration) *RequestVerifier {
+	v.tolerance = tolerance
+	return v
+}
+
+// Verify checks the signature of r and that its nonce has not been seen
+// before. The body of r is restored after it is read.
+func (v *RequestVerifier) Verify(r *http.Request) error {
+	secret, err := v.secret(r)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	now := v.now()
+	expires, err := verifyRequest(r, secret, v.tolerance, now)
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// A replay carries the same signed timestamp, so it falls in the same
+	// bucket as the original
+	width := v.bucketWidth()
+	for bucket := range v.nonces {
+		if !now.Before(time.Unix(0, (bucket+1)*int64(width))) {
+			delete(v.nonces, bucket)
+		}
+	}
+
+	bucket := v.nonceBucket(expires)
+	nonce := r.Header.Get(HeaderSignatureNonce)
+	if _, seen := v.nonces[bucket][nonce]; seen {
+		return fmt.Errorf("%w: replayed nonce", ErrInvalidSignature)
+	}
+	if v.nonces[bucket] == nil {
+		v.nonces[bucket] = make(map[string]struct{})
+	}
+	v.nonces[bucket][nonce] = struct{}{}
+	return nil
+}
+
+// forget removes the nonce of a verified request, so the same request is
+// accepted again, e.g. when a webhook delivery could not be processed and
+// will be retried
+func (v *RequestVerifier) forget(r *http.Request) {
+	seconds, err := strconv.ParseInt(r.Header.Get(HeaderSignatureTimestamp), 10, 64)
+	if err != nil {
+		return
+	}
+	tolerance := v.tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultSignatureTolerance
+	}
+	bucket := v.nonceBucket(time.Unix(seconds, 0).Add(tolerance))
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.nonces[bucket], r.Header.Get(HeaderSignatureNonce))
+}
+
+// nonceBucket returns the bucket of a nonce whose signature expires at
+// expires
+func (v *RequestVerifier) nonceBucket(expires time.Time) int64 {
+	return expires.UnixNano() / int64(v.bucketWidth())
+}
+
+// bucketWidth returns the time span of a nonce bucket
+func (v *RequestVerifier) bucketWidth() time.Duration {
+	tolerance := v.tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultSignatureTolerance
+	}
+	if width := tolerance / nonceBuckets; width >= time.Second {
+		return width
+	}
+	return time.Second
+}
+
+// Handler wraps next, answering 401 Unauthorized for requests that fail
+// verification
+func (v *RequestVerifier) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := v.Verify(r); err != nil {
+			writeErrorDetail(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// writeErrorDetail writes a JSON error response in the API's format
+func writeErrorDetail(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"detail": detail})
+}
+
+// computeSignature returns the hex HMAC-SHA256 of the canonical request
+func computeSignature(secret, method, requestURI, timestamp, nonce string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	canonical := strings.Join([]string{
+		strings.ToUpper(method),
+		requestURI,
+		timestamp,
+		nonce,
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(canonical))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// requestBody returns the body of req without consuming it
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
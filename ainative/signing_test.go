@@ -0,0 +1,189 @@
+package ainative
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticSecret returns a verifier secret lookup for a single secret
+func staticSecret(secret string) func(*http.Request) (string, error) {
+	return func(*http.Request) (string, error) {
+		return secret, nil
+	}
+}
+
+func TestSignedRequests(t *testing.T) {
+	var attempts int32
+	var captured []*http.Request
+
+	verifier := NewRequestVerifier(staticSecret("test-secret"))
+	server := httptest.NewServer(verifier.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		captured = append(captured, r.Clone(context.Background()))
+
+		// Fail the first attempt so the retry must be signed afresh
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "mem_1", "content": "hello"}`))
+	})))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:       "test-key",
+		APISecret:    "test-secret",
+		SignRequests: true,
+		BaseURL:      server.URL,
+		RetryConfig:  &RetryConfig{MaxRetries: 2, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	memory, err := client.ZeroDB.Memory.Create(context.Background(), &CreateMemoryRequest{Content: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, "mem_1", memory.ID)
+	require.Len(t, captured, 2)
+	assert.NotEqual(t, captured[0].Header.Get(HeaderSignatureNonce), captured[1].Header.Get(HeaderSignatureNonce))
+	for _, r := range captured {
+		assert.True(t, strings.HasPrefix(r.Header.Get(HeaderSignature), "v1="))
+		assert.NotContains(t, r.Header.Get("Authorization"), "test-secret")
+	}
+}
+
+func TestSignRequests_DefaultsToSecretInBearer(t *testing.T) {
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status": "healthy"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", APISecret: "test-secret", BaseURL: server.URL})
+	require.NoError(t, err)
+	_, err = client.Health(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, headers, 1)
+	assert.Equal(t, "Bearer test-key:test-secret", headers[0].Get("Authorization"))
+	assert.Empty(t, headers[0].Get(HeaderSignature))
+
+	_, err = NewClient(&Config{APIKey: "test-key", SignRequests: true, BaseURL: server.URL})
+	var configErr *ConfigError
+	require.True(t, errors.As(err, &configErr))
+	assert.Equal(t, "api_secret", configErr.Field)
+}
+
+// newSignedRequest returns a request signed with secret
+func newSignedRequest(t *testing.T, body string, secret string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/memory?tag=a%20b", strings.NewReader(body))
+	require.NoError(t, SignRequest(req, secret))
+	return req
+}
+
+func TestVerifyRequest(t *testing.T) {
+	req := newSignedRequest(t, `{"content": "hello"}`, "secret")
+	require.NoError(t, VerifyRequest(req, "secret", 0))
+
+	// The body is still readable after verification
+	var buf bytes.Buffer
+	buf.ReadFrom(req.Body)
+	assert.Equal(t, `{"content": "hello"}`, buf.String())
+
+	tests := []struct {
+		name   string
+		mutate func(r *http.Request)
+		secret string
+	}{
+		{"wrong secret", func(r *http.Request) {}, "other"},
+		{"tampered body", func(r *http.Request) {
+			r.Body = io.NopCloser(strings.NewReader(`{"content": "goodbye"}`))
+		}, "secret"},
+		{"tampered path", func(r *http.Request) { r.URL.Path = "/api/v1/memory/search" }, "secret"},
+		{"tampered method", func(r *http.Request) { r.Method = http.MethodPut }, "secret"},
+		{"stale timestamp", func(r *http.Request) {
+			r.Header.Set(HeaderSignatureTimestamp, strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10))
+		}, "secret"},
+		{"missing signature", func(r *http.Request) { r.Header.Del(HeaderSignature) }, "secret"},
+		{"unknown version", func(r *http.Request) {
+			r.Header.Set(HeaderSignature, strings.Replace(r.Header.Get(HeaderSignature), "v1=", "v9=", 1))
+		}, "secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newSignedRequest(t, `{"content": "hello"}`, "secret")
+			tt.mutate(req)
+			err := VerifyRequest(req, tt.secret, 0)
+			assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+		})
+	}
+}
+
+func TestRequestVerifier_RejectsReplays(t *testing.T) {
+	now := time.Now()
+	verifier := NewRequestVerifier(staticSecret("secret")).WithTolerance(time.Minute)
+	verifier.now = func() time.Time { return now }
+
+	req := newSignedRequest(t, `{}`, "secret")
+	require.NoError(t, verifier.Verify(req))
+
+	err := verifier.Verify(req)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	assert.Contains(t, err.Error(), "replayed nonce")
+
+	// Once the nonce is forgotten, the timestamp check still rejects replays
+	now = now.Add(2 * time.Minute)
+	err = verifier.Verify(req)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	assert.Contains(t, err.Error(), "timestamp outside tolerance")
+
+	// Expired nonces are dropped a bucket at a time
+	now = time.Now()
+	for i := 0; i < 20; i++ {
+		require.NoError(t, verifier.Verify(newSignedRequest(t, `{}`, "secret")))
+	}
+	assert.LessOrEqual(t, len(verifier.nonces), 2)
+
+	now = now.Add(3 * time.Minute)
+	later := httptest.NewRequest(http.MethodGet, "/health", nil)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	later.Header.Set(HeaderSignatureTimestamp, timestamp)
+	later.Header.Set(HeaderSignatureNonce, "later")
+	later.Header.Set(HeaderSignature, "v1="+computeSignature("secret", http.MethodGet, "/health", timestamp, "later", nil))
+	require.NoError(t, verifier.Verify(later))
+	assert.Len(t, verifier.nonces, 1)
+
+	lookupErr := errors.New("unknown key")
+	failing := NewRequestVerifier(func(*http.Request) (string, error) { return "", lookupErr })
+	assert.True(t, errors.Is(failing.Verify(newSignedRequest(t, `{}`, "secret")), ErrInvalidSignature))
+}
+
+func TestRequestVerifier_Handler(t *testing.T) {
+	handler := NewRequestVerifier(staticSecret("secret")).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newSignedRequest(t, `{}`, "secret"))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "missing signature headers")
+}
@@ -0,0 +1,151 @@
+package ainative
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the SDK to OpenTelemetry
+const instrumentationName = "ainative-go-sdk"
+
+// Metric instrument names
+const (
+	MetricRequestDuration = "ainative.client.request.duration"
+	MetricRequestRetries  = "ainative.client.request.retries"
+	MetricRateLimitWait   = "ainative.client.rate_limit.wait"
+)
+
+// contextRouteKey carries the route template of a request in its context
+type contextRouteKey struct{}
+
+// withRoute returns ctx carrying route, so that transports below the client,
+// such as Cassette, can see the template the request was built from
+func withRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, contextRouteKey{}, route)
+}
+
+// routeFromContext returns the route template stored in ctx, or "" if none
+func routeFromContext(ctx context.Context) string {
+	route, _ := ctx.Value(contextRouteKey{}).(string)
+	return route
+}
+
+// clientMetrics holds the SDK's metric instruments
+type clientMetrics struct {
+	duration      metric.Float64Histogram
+	retries       metric.Int64Counter
+	rateLimitWait metric.Float64Histogram
+}
+
+// newClientMetrics creates the metric instruments from provider
+func newClientMetrics(provider metric.MeterProvider) (*clientMetrics, error) {
+	if provider == nil {
+		provider = otel.GetMeterProvider()
+	}
+	meter := provider.Meter(instrumentationName, metric.WithInstrumentationVersion(UserAgent))
+
+	duration, err := meter.Float64Histogram(MetricRequestDuration,
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of AINative API calls, including retries"))
+	if err != nil {
+		return nil, err
+	}
+
+	retries, err := meter.Int64Counter(MetricRequestRetries,
+		metric.WithUnit("{retry}"),
+		metric.WithDescription("Number of retried AINative API requests"))
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitWait, err := meter.Float64Histogram(MetricRateLimitWait,
+		metric.WithUnit("s"),
+		metric.WithDescription("Time spent waiting for the client rate limiter"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientMetrics{
+		duration:      duration,
+		retries:       retries,
+		rateLimitWait: rateLimitWait,
+	}, nil
+}
+
+// startSpan starts a client span for a request and injects the trace context
+// into its headers
+func (c *Client) startSpan(ctx context.Context, req *Request, route string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.HTTPRouteKey.String(route),
+	}
+	if baseURL, err := url.Parse(c.config.BaseURL); err == nil {
+		attrs = append(attrs, semconv.ServerAddressKey.String(baseURL.Hostname()))
+		if port, err := strconv.Atoi(baseURL.Port()); err == nil {
+			attrs = append(attrs, semconv.ServerPortKey.Int(port))
+		}
+	}
+
+	ctx, span := c.tracer.Start(ctx, "ainative."+req.Method+" "+route,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	c.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return ctx, span
+}
+
+// finishTelemetry records the outcome of a request on its span and metrics
+func (c *Client) finishTelemetry(ctx context.Context, span trace.Span, req *Request, route string, duration time.Duration, err error) {
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.HTTPRouteKey.String(route),
+	}
+
+	if req.Response != nil {
+		status := semconv.HTTPResponseStatusCodeKey.Int(req.Response.StatusCode)
+		span.SetAttributes(status)
+		attrs = append(attrs, status)
+
+		if req.Response.Attempts > 1 {
+			retries := int64(req.Response.Attempts - 1)
+			span.SetAttributes(semconv.HTTPResendCountKey.Int64(retries))
+			c.metrics.retries.Add(ctx, retries, metric.WithAttributes(attrs...))
+		}
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		errorType := "error"
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			errorType = strconv.Itoa(apiErr.StatusCode)
+		}
+		attrs = append(attrs, attribute.String("error.type", errorType))
+	}
+
+	c.metrics.duration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
+}
+
+// recordRateLimitWait records time spent blocked by the client rate limiter
+func (c *Client) recordRateLimitWait(ctx context.Context, req *Request, waited time.Duration) {
+	c.metrics.rateLimitWait.Record(ctx, waited.Seconds(), metric.WithAttributes(
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.HTTPRouteKey.String(routeFromContext(ctx)),
+	))
+	c.logRateLimitWait(ctx, req, waited)
+}
@@ -0,0 +1,208 @@
+package ainative
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// recordingSpan captures what the client records on a span
+type recordingSpan struct {
+	tracenoop.Span
+	name    string
+	kind    trace.SpanKind
+	attrs   map[attribute.Key]attribute.Value
+	status  codes.Code
+	errors  []error
+	spanCtx trace.SpanContext
+	ended   bool
+}
+
+func (s *recordingSpan) SpanContext() trace.SpanContext { return s.spanCtx }
+func (s *recordingSpan) IsRecording() bool              { return true }
+func (s *recordingSpan) End(...trace.SpanEndOption)     { s.ended = true }
+func (s *recordingSpan) RecordError(err error, _ ...trace.EventOption) {
+	s.errors = append(s.errors, err)
+}
+func (s *recordingSpan) SetStatus(code codes.Code, _ string) { s.status = code }
+func (s *recordingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	for _, attr := range kv {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+// recordingTracer creates recordingSpans
+type recordingTracer struct {
+	tracenoop.Tracer
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	config := trace.NewSpanStartConfig(opts...)
+	span := &recordingSpan{
+		name:  name,
+		kind:  config.SpanKind(),
+		attrs: make(map[attribute.Key]attribute.Value),
+		spanCtx: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{0x01, 0x02, 0x03},
+			SpanID:     trace.SpanID{0x04, 0x05},
+			TraceFlags: trace.FlagsSampled,
+		}),
+	}
+	span.SetAttributes(config.Attributes()...)
+	t.spans = append(t.spans, span)
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+// recordingMeter captures metric measurements by instrument name
+type recordingMeter struct {
+	metricnoop.Meter
+	mu           sync.Mutex
+	measurements map[string][]measurement
+}
+
+type measurement struct {
+	value float64
+	attrs attribute.Set
+}
+
+func (m *recordingMeter) record(name string, value float64, attrs attribute.Set) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.measurements[name] = append(m.measurements[name], measurement{value: value, attrs: attrs})
+}
+
+func (m *recordingMeter) get(name string) []measurement {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.measurements[name]
+}
+
+func (m *recordingMeter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return &recordingHistogram{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return &recordingCounter{meter: m, name: name}, nil
+}
+
+type recordingHistogram struct {
+	metricnoop.Float64Histogram
+	meter *recordingMeter
+	name  string
+}
+
+func (h *recordingHistogram) Record(_ context.Context, value float64, opts ...metric.RecordOption) {
+	h.meter.record(h.name, value, metric.NewRecordConfig(opts).Attributes())
+}
+
+type recordingCounter struct {
+	metricnoop.Int64Counter
+	meter *recordingMeter
+	name  string
+}
+
+func (c *recordingCounter) Add(_ context.Context, value int64, opts ...metric.AddOption) {
+	c.meter.record(c.name, float64(value), metric.NewAddConfig(opts).Attributes())
+}
+
+type recordingMeterProvider struct {
+	metricnoop.MeterProvider
+	meter *recordingMeter
+}
+
+func (p *recordingMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return p.meter
+}
+
+func TestTelemetry_SpanAttributesAndPropagation(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "proj_123"}`))
+	}))
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client, err := NewClient(&Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+		Tracer:  tracer,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ZeroDB.Projects.Get(context.Background(), "proj_123")
+	require.NoError(t, err)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "ainative.GET /api/v1/zerodb/projects/{id}", span.name)
+	assert.Equal(t, trace.SpanKindClient, span.kind)
+	assert.Equal(t, "GET", span.attrs["http.request.method"].AsString())
+	assert.Equal(t, "/api/v1/zerodb/projects/{id}", span.attrs["http.route"].AsString())
+	assert.Equal(t, "127.0.0.1", span.attrs["server.address"].AsString())
+	assert.Equal(t, int64(200), span.attrs["http.response.status_code"].AsInt64())
+	assert.Equal(t, codes.Unset, span.status)
+	assert.True(t, span.ended)
+
+	assert.Equal(t, "00-01020300000000000000000000000000-0405000000000000-01", traceparent)
+}
+
+func TestTelemetry_RecordsErrorsAndMetrics(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"message": "unavailable"}`))
+	}))
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	meter := &recordingMeter{measurements: make(map[string][]measurement)}
+	client, err := NewClient(&Config{
+		APIKey:        "test-key",
+		BaseURL:       server.URL,
+		Tracer:        tracer,
+		MeterProvider: &recordingMeterProvider{meter: meter},
+		RetryConfig:   &RetryConfig{MaxRetries: 2, InitialDelay: 5 * time.Millisecond, MaxDelay: 10 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	_, err = client.AgentSwarm.Get(context.Background(), "swarm_123")
+	require.Error(t, err)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, codes.Error, span.status)
+	require.Len(t, span.errors, 1)
+	assert.Equal(t, int64(503), span.attrs["http.response.status_code"].AsInt64())
+	assert.Equal(t, int64(2), span.attrs["http.resend_count"].AsInt64())
+
+	durations := meter.get(MetricRequestDuration)
+	require.Len(t, durations, 1)
+	route, _ := durations[0].attrs.Value("http.route")
+	assert.Equal(t, "/api/v1/agent-swarm/swarms/{id}", route.AsString())
+	errorType, _ := durations[0].attrs.Value("error.type")
+	assert.Equal(t, "503", errorType.AsString())
+
+	retries := meter.get(MetricRequestRetries)
+	require.Len(t, retries, 1)
+	assert.Equal(t, float64(2), retries[0].value)
+
+	assert.Len(t, meter.get(MetricRateLimitWait), 1)
+}
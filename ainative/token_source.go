@@ -0,0 +1,217 @@
+package ainative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultTokenRefreshSkew is how long before expiry a cached token is refreshed
+const DefaultTokenRefreshSkew = 60 * time.Second
+
+// TokenSource supplies bearer tokens for API requests. Implementations must be
+// safe for concurrent use.
+type TokenSource interface {
+	// Token returns a valid access token, logging in or refreshing as needed
+	Token(ctx context.Context) (*TokenResponse, error)
+
+	// Invalidate discards accessToken if it is still the cached token, forcing
+	// the next call to Token to obtain a new one
+	Invalidate(accessToken string)
+}
+
+// clientBinder is implemented by token sources that need the client they are
+// configured on to talk to the auth endpoints
+type clientBinder interface {
+	bindClient(client *Client)
+}
+
+// LoginTokenSource is a TokenSource that logs in with username and password
+// through AuthService, caches the resulting token and refreshes it before it
+// expires. When set as Config.TokenSource it is bound to that client
+// automatically.
+type LoginTokenSource struct {
+	// Credentials used for the initial login and as a fallback when a
+	// refresh fails
+	Credentials LoginRequest
+
+	// RefreshSkew is how long before ExpiresAt the token is refreshed
+	// (defaults to DefaultTokenRefreshSkew)
+	RefreshSkew time.Duration
+
+	mu         sync.Mutex
+	auth       *AuthService
+	token      *TokenResponse
+	refreshing chan struct{} // closed when the token request in flight ends
+	now        func() time.Time
+}
+
+// NewLoginTokenSource creates a token source that logs in with the given credentials
+func NewLoginTokenSource(username, password string) *LoginTokenSource {
+	return &LoginTokenSource{
+		Credentials: LoginRequest{
+			Username: username,
+			Password: password,
+		},
+		RefreshSkew: DefaultTokenRefreshSkew,
+	}
+}
+
+// bindClient attaches the auth service used to obtain tokens
+func (s *LoginTokenSource) bindClient(client *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.auth == nil {
+		s.auth = client.Auth
+	}
+}
+
+// Token returns the cached token, refreshing it if it is missing or about to
+// expire. Only one caller talks to the auth endpoints at a time; the others
+// wait for its result until their own ctx is done.
+func (s *LoginTokenSource) Token(ctx context.Context) (*TokenResponse, error) {
+	for {
+		s.mu.Lock()
+		if s.auth == nil {
+			s.mu.Unlock()
+			return nil, NewConfigError("token_source", "token source is not bound to a client")
+		}
+
+		if s.token != nil && !s.expiring(s.token) {
+			token := s.token
+			s.mu.Unlock()
+			return token, nil
+		}
+
+		if refreshing := s.refreshing; refreshing != nil {
+			s.mu.Unlock()
+			select {
+			case <-refreshing:
+				// Use the new token, or try ourselves if that request failed
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		refreshing := make(chan struct{})
+		s.refreshing = refreshing
+		current := s.token
+		s.mu.Unlock()
+
+		token, err := s.obtain(ctx, current)
+
+		s.mu.Lock()
+		if err == nil {
+			s.token = token
+		}
+		s.refreshing = nil
+		close(refreshing)
+		s.mu.Unlock()
+
+		return token, err
+	}
+}
+
+// obtain refreshes current, falling back to logging in with the credentials
+func (s *LoginTokenSource) obtain(ctx context.Context, current *TokenResponse) (*TokenResponse, error) {
+	// Auth calls must not recurse into the token middleware, and must not use
+	// the idempotency key or ResponseMeta of the call that needed the token
+	ctx = detachCallValues(withoutTokenSource(ctx))
+
+	if current != nil && current.RefreshToken != "" {
+		token, err := s.auth.RefreshToken(ctx, &RefreshTokenRequest{RefreshToken: current.RefreshToken})
+		if err == nil {
+			return token, nil
+		}
+	}
+
+	token, err := s.auth.Login(ctx, &s.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain access token: %w", err)
+	}
+	return token, nil
+}
+
+// Invalidate discards the cached token if it matches accessToken
+func (s *LoginTokenSource) Invalidate(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken == accessToken {
+		s.token = nil
+	}
+}
+
+// expiring reports whether token expires within the refresh skew
+func (s *LoginTokenSource) expiring(token *TokenResponse) bool {
+	if token.ExpiresAt.IsZero() {
+		return false
+	}
+
+	now := time.Now
+	if s.now != nil {
+		now = s.now
+	}
+
+	skew := s.RefreshSkew
+	if skew <= 0 {
+		skew = DefaultTokenRefreshSkew
+	}
+
+	return !now().Add(skew).Before(token.ExpiresAt)
+}
+
+type skipTokenSourceKey struct{}
+
+// withoutTokenSource marks ctx so the token middleware leaves the request alone
+func withoutTokenSource(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipTokenSourceKey{}, true)
+}
+
+// tokenSourceMiddleware sets the Authorization header from source and retries
+// once with a fresh token when the server answers 401
+func tokenSourceMiddleware(source TokenSource) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) error {
+			if skip, _ := ctx.Value(skipTokenSourceKey{}).(bool); skip {
+				return next(ctx, req)
+			}
+
+			token, err := source.Token(ctx)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Authorization", authorizationValue(token))
+
+			err = next(ctx, req)
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+				return err
+			}
+
+			source.Invalidate(token.AccessToken)
+
+			token, tokenErr := source.Token(ctx)
+			if tokenErr != nil {
+				return err
+			}
+			req.Header.Set("Authorization", authorizationValue(token))
+
+			return next(ctx, req)
+		}
+	}
+}
+
+// authorizationValue formats token as an Authorization header value
+func authorizationValue(token *TokenResponse) string {
+	tokenType := token.TokenType
+	if tokenType == "" || tokenType == "bearer" {
+		tokenType = "Bearer"
+	}
+	return fmt.Sprintf("%s %s", tokenType, token.AccessToken)
+}
@@ -0,0 +1,258 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer is a fake auth server that issues numbered tokens
+type tokenServer struct {
+	logins    int32
+	refreshes int32
+	expiresIn int
+	reject    sync.Map // access tokens the server treats as revoked
+}
+
+func (s *tokenServer) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/auth/login":
+			assert.Empty(t, r.Header.Get("Authorization"))
+
+			var req LoginRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, "user", req.Username)
+
+			n := atomic.AddInt32(&s.logins, 1)
+			json.NewEncoder(w).Encode(TokenResponse{
+				AccessToken:  fmt.Sprintf("login-%d", n),
+				TokenType:    "bearer",
+				ExpiresIn:    s.expiresIn,
+				RefreshToken: fmt.Sprintf("refresh-%d", n),
+				IssuedAt:     time.Now(),
+			})
+		case "/api/v1/auth/refresh":
+			n := atomic.AddInt32(&s.refreshes, 1)
+			json.NewEncoder(w).Encode(TokenResponse{
+				AccessToken:  fmt.Sprintf("refreshed-%d", n),
+				TokenType:    "bearer",
+				ExpiresIn:    s.expiresIn,
+				RefreshToken: fmt.Sprintf("refresh-r%d", n),
+				IssuedAt:     time.Now(),
+			})
+		default:
+			auth := r.Header.Get("Authorization")
+			if _, revoked := s.reject.Load(auth); revoked {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"message": "token expired"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{"authorization": auth})
+		}
+	}
+}
+
+func TestLoginTokenSource_CachesToken(t *testing.T) {
+	ts := &tokenServer{expiresIn: 3600}
+	server := httptest.NewServer(ts.handler(t))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		TokenSource: NewLoginTokenSource("user", "pass"),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		var result map[string]string
+		err = client.makeRequest(ctx, "GET", endpoint("/test"), nil, &result)
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer login-1", result["authorization"])
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&ts.logins))
+}
+
+func TestLoginTokenSource_RefreshesBeforeExpiry(t *testing.T) {
+	ts := &tokenServer{expiresIn: 3600}
+	server := httptest.NewServer(ts.handler(t))
+	defer server.Close()
+
+	source := NewLoginTokenSource("user", "pass")
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		TokenSource: source,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	var result map[string]string
+	require.NoError(t, client.makeRequest(ctx, "GET", endpoint("/test"), nil, &result))
+	assert.Equal(t, "Bearer login-1", result["authorization"])
+
+	// Move the clock to within the refresh skew
+	source.now = func() time.Time { return time.Now().Add(3600*time.Second - 30*time.Second) }
+
+	require.NoError(t, client.makeRequest(ctx, "GET", endpoint("/test"), nil, &result))
+	assert.Equal(t, "Bearer refreshed-1", result["authorization"])
+	assert.Equal(t, int32(1), atomic.LoadInt32(&ts.logins))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&ts.refreshes))
+}
+
+func TestLoginTokenSource_RetriesOnceOn401(t *testing.T) {
+	ts := &tokenServer{expiresIn: 3600}
+	ts.reject.Store("Bearer login-1", true)
+	server := httptest.NewServer(ts.handler(t))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		TokenSource: NewLoginTokenSource("user", "pass"),
+	})
+	require.NoError(t, err)
+
+	var result map[string]string
+	err = client.makeRequest(context.Background(), "GET", endpoint("/test"), nil, &result)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer login-2", result["authorization"])
+	assert.Equal(t, int32(2), atomic.LoadInt32(&ts.logins))
+}
+
+func TestLoginTokenSource_ConcurrentUse(t *testing.T) {
+	ts := &tokenServer{expiresIn: 3600}
+	server := httptest.NewServer(ts.handler(t))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		TokenSource: NewLoginTokenSource("user", "pass"),
+		RateLimit:   1000,
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var result map[string]string
+			err := client.makeRequest(context.Background(), "GET", endpoint("/test"), nil, &result)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&ts.logins))
+}
+
+func TestLoginTokenSource_Unbound(t *testing.T) {
+	source := NewLoginTokenSource("user", "pass")
+
+	_, err := source.Token(context.Background())
+
+	require.Error(t, err)
+	_, ok := err.(*ConfigError)
+	assert.True(t, ok)
+}
+
+func TestLoginTokenSource_WaitersRespectContext(t *testing.T) {
+	loginStarted := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(loginStarted)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TokenResponse{AccessToken: "login-1", ExpiresIn: 3600})
+	}))
+	defer server.Close()
+
+	source := NewLoginTokenSource("user", "pass")
+	_, err := NewClient(&Config{BaseURL: server.URL, TokenSource: source})
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := source.Token(context.Background())
+		done <- err
+	}()
+	<-loginStarted
+
+	// A second caller gives up when its context ends instead of blocking
+	// until the login in flight completes
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = source.Token(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	require.NoError(t, <-done)
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "login-1", token.AccessToken)
+}
+
+func TestLoginTokenSource_DetachesCallValues(t *testing.T) {
+	var loginKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/auth/login" {
+			loginKey = r.Header.Get(HeaderIdempotencyKey)
+			w.Header().Set(HeaderRequestID, "req-login")
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: "login-1", ExpiresIn: 3600})
+			return
+		}
+		assert.Equal(t, "call-1", r.Header.Get(HeaderIdempotencyKey))
+		w.Header().Set(HeaderRequestID, "req-call")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		TokenSource: NewLoginTokenSource("user", "pass"),
+	})
+	require.NoError(t, err)
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(WithIdempotencyKey(context.Background(), "call-1"), &meta)
+	require.NoError(t, client.makeRequest(ctx, "POST", endpoint("/test"), nil, nil))
+
+	assert.NotEmpty(t, loginKey)
+	assert.NotEqual(t, "call-1", loginKey)
+	assert.Equal(t, "req-call", meta.RequestID)
+}
+
+func TestLoginTokenSource_TokenWithoutExpiry(t *testing.T) {
+	ts := &tokenServer{}
+	server := httptest.NewServer(ts.handler(t))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		TokenSource: NewLoginTokenSource("user", "pass"),
+	})
+	require.NoError(t, err)
+
+	// A token without expires_in never expires, so it is reused
+	for i := 0; i < 5; i++ {
+		var result map[string]string
+		require.NoError(t, client.makeRequest(context.Background(), "GET", endpoint("/test"), nil, &result))
+		assert.Equal(t, "Bearer login-1", result["authorization"])
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&ts.logins))
+}
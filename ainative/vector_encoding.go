@@ -0,0 +1,355 @@
+package ainative
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// VectorEncoding selects how vector values are sent over the wire
+type VectorEncoding string
+
+const (
+	// VectorEncodingJSON sends vectors as JSON number arrays (the default)
+	VectorEncodingJSON VectorEncoding = "json"
+
+	// VectorEncodingBase64 sends vectors as base64 strings of little-endian
+	// float32 values, roughly a third of the size of JSON numbers and much
+	// cheaper to encode. Values are rounded to float32 precision.
+	VectorEncodingBase64 VectorEncoding = "base64"
+)
+
+// HeaderVectorEncoding tells the server the encoding of vectors in the
+// request body and the encoding to use for vectors in the response
+const HeaderVectorEncoding = "X-AINative-Vector-Encoding"
+
+// EncodeVector returns v as a base64 string of little-endian float32 values
+func EncodeVector(v []float32) string {
+	buf := make([]byte, 4*len(v))
+	for i, f := range v {
+		binary.LittleEndian.PutUint32(buf[4*i:], math.Float32bits(f))
+	}
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+// DecodeVector decodes a vector encoded by EncodeVector
+func DecodeVector(s string) ([]float32, error) {
+	buf, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("ainative: invalid vector encoding: %w", err)
+	}
+	if len(buf)%4 != 0 {
+		return nil, fmt.Errorf("ainative: invalid vector encoding: %d bytes is not a whole number of float32 values", len(buf))
+	}
+
+	v := make([]float32, len(buf)/4)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:]))
+	}
+	return v, nil
+}
+
+// Float32s converts v to float32
+func Float32s(v []float64) []float32 {
+	if v == nil {
+		return nil
+	}
+	out := make([]float32, len(v))
+	for i, f := range v {
+		out[i] = float32(f)
+	}
+	return out
+}
+
+// Float64s converts v to float64
+func Float64s(v []float32) []float64 {
+	if v == nil {
+		return nil
+	}
+	out := make([]float64, len(v))
+	for i, f := range v {
+		out[i] = float64(f)
+	}
+	return out
+}
+
+// vectorValue is a vector held as float64 or float32 values. It marshals as
+// a JSON number array, or as a base64 string when compact is set.
+type vectorValue struct {
+	f64     []float64
+	f32     []float32
+	compact bool
+}
+
+// MarshalJSON implements json.Marshaler
+func (v vectorValue) MarshalJSON() ([]byte, error) {
+	switch {
+	case v.compact && v.f32 != nil:
+		return json.Marshal(EncodeVector(v.f32))
+	case v.compact:
+		return json.Marshal(EncodeVector(Float32s(v.f64)))
+	case v.f64 == nil && v.f32 != nil:
+		return json.Marshal(v.f32)
+	default:
+		return json.Marshal(v.f64)
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Number arrays decode into f64
+// and base64 strings into f32.
+func (v *vectorValue) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		f32, err := DecodeVector(s)
+		if err != nil {
+			return err
+		}
+		v.f32 = f32
+		return nil
+	}
+	return json.Unmarshal(data, &v.f64)
+}
+
+// Float32 returns the values of the vector as float32, converting Vector if
+// Vector32 is not set
+func (v VectorItem) Float32() []float32 {
+	if v.Vector32 != nil {
+		return v.Vector32
+	}
+	return Float32s(v.Vector)
+}
+
+// Float64 returns the values of the vector as float64, converting Vector32 if
+// Vector is not set
+func (v VectorItem) Float64() []float64 {
+	if v.Vector != nil {
+		return v.Vector
+	}
+	return Float64s(v.Vector32)
+}
+
+// MarshalJSON implements json.Marshaler, sending Vector32 when Vector is not
+// set
+func (v VectorItem) MarshalJSON() ([]byte, error) {
+	return v.marshalJSON(false)
+}
+
+func (v VectorItem) marshalJSON(compact bool) ([]byte, error) {
+	type alias VectorItem
+	return json.Marshal(struct {
+		alias
+		Vector vectorValue `json:"vector"`
+	}{alias(v), vectorValue{v.Vector, v.Vector32, compact}})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Number arrays decode into
+// Vector and compact vectors into Vector32.
+func (v *VectorItem) UnmarshalJSON(data []byte) error {
+	type alias VectorItem
+	aux := struct {
+		*alias
+		Vector vectorValue `json:"vector"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	v.Vector, v.Vector32 = aux.Vector.f64, aux.Vector.f32
+	return nil
+}
+
+// Float32 returns the query vector as float32
+func (r VectorSearchRequest) Float32() []float32 {
+	if r.Vector32 != nil {
+		return r.Vector32
+	}
+	return Float32s(r.Vector)
+}
+
+// Float64 returns the query vector as float64
+func (r VectorSearchRequest) Float64() []float64 {
+	if r.Vector != nil {
+		return r.Vector
+	}
+	return Float64s(r.Vector32)
+}
+
+// MarshalJSON implements json.Marshaler, sending Vector32 when Vector is not
+// set
+func (r VectorSearchRequest) MarshalJSON() ([]byte, error) {
+	return r.marshalJSON(false)
+}
+
+func (r VectorSearchRequest) marshalJSON(compact bool) ([]byte, error) {
+	type alias VectorSearchRequest
+	return json.Marshal(struct {
+		alias
+		Vector vectorValue `json:"vector"`
+	}{alias(r), vectorValue{r.Vector, r.Vector32, compact}})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (r *VectorSearchRequest) UnmarshalJSON(data []byte) error {
+	type alias VectorSearchRequest
+	aux := struct {
+		*alias
+		Vector vectorValue `json:"vector"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Vector, r.Vector32 = aux.Vector.f64, aux.Vector.f32
+	return nil
+}
+
+// Float32 returns the values of the match as float32
+func (m VectorSearchMatch) Float32() []float32 {
+	if m.Vector32 != nil {
+		return m.Vector32
+	}
+	return Float32s(m.Vector)
+}
+
+// Float64 returns the values of the match as float64
+func (m VectorSearchMatch) Float64() []float64 {
+	if m.Vector != nil {
+		return m.Vector
+	}
+	return Float64s(m.Vector32)
+}
+
+// MarshalJSON implements json.Marshaler
+func (m VectorSearchMatch) MarshalJSON() ([]byte, error) {
+	type alias VectorSearchMatch
+	aux := struct {
+		alias
+		Vector *vectorValue `json:"vector,omitempty"`
+	}{alias: alias(m)}
+	if m.Vector != nil || m.Vector32 != nil {
+		aux.Vector = &vectorValue{f64: m.Vector, f32: m.Vector32}
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (m *VectorSearchMatch) UnmarshalJSON(data []byte) error {
+	type alias VectorSearchMatch
+	aux := struct {
+		*alias
+		Vector vectorValue `json:"vector"`
+	}{alias: (*alias)(m)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	m.Vector, m.Vector32 = aux.Vector.f64, aux.Vector.f32
+	return nil
+}
+
+// Float32 returns the embeddings as float32
+func (r GenerateResponse) Float32() [][]float32 {
+	if r.Embeddings32 != nil {
+		return r.Embeddings32
+	}
+	out := make([][]float32, len(r.Embeddings))
+	for i, embedding := range r.Embeddings {
+		out[i] = Float32s(embedding)
+	}
+	return out
+}
+
+// MarshalJSON implements json.Marshaler, sending Embeddings32 when
+// Embeddings is not set
+func (r GenerateResponse) MarshalJSON() ([]byte, error) {
+	type alias GenerateResponse
+	aux := struct {
+		alias
+		Embeddings interface{} `json:"embeddings"`
+	}{alias: alias(r), Embeddings: r.Embeddings}
+	if r.Embeddings == nil && r.Embeddings32 != nil {
+		aux.Embeddings = r.Embeddings32
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Embeddings sent as number
+// arrays decode into Embeddings; compact embeddings decode into
+// Embeddings32.
+func (r *GenerateResponse) UnmarshalJSON(data []byte) error {
+	type alias GenerateResponse
+	aux := struct {
+		*alias
+		Embeddings []vectorValue `json:"embeddings"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	r.Embeddings, r.Embeddings32 = nil, nil
+	if aux.Embeddings == nil {
+		return nil
+	}
+
+	compact := false
+	for _, embedding := range aux.Embeddings {
+		if embedding.f32 != nil {
+			compact = true
+			break
+		}
+	}
+	if compact {
+		r.Embeddings32 = make([][]float32, len(aux.Embeddings))
+		for i, embedding := range aux.Embeddings {
+			r.Embeddings32[i] = embedding.f32
+			if embedding.f32 == nil {
+				r.Embeddings32[i] = Float32s(embedding.f64)
+			}
+		}
+		return nil
+	}
+
+	r.Embeddings = make([][]float64, len(aux.Embeddings))
+	for i, embedding := range aux.Embeddings {
+		r.Embeddings[i] = embedding.f64
+	}
+	return nil
+}
+
+// compactVectorItem marshals a VectorItem with a compact vector
+type compactVectorItem VectorItem
+
+// MarshalJSON implements json.Marshaler
+func (v compactVectorItem) MarshalJSON() ([]byte, error) {
+	return VectorItem(v).marshalJSON(true)
+}
+
+// compactSearchRequest marshals a VectorSearchRequest with a compact vector
+type compactSearchRequest VectorSearchRequest
+
+// MarshalJSON implements json.Marshaler
+func (r compactSearchRequest) MarshalJSON() ([]byte, error) {
+	return VectorSearchRequest(r).marshalJSON(true)
+}
+
+// compactUpsertRequest marshals an UpsertVectorsRequest with compact vectors
+type compactUpsertRequest struct {
+	Vectors   []compactVectorItem `json:"vectors"`
+	Namespace string              `json:"namespace,omitempty"`
+}
+
+// newCompactUpsertRequest returns req with compact vectors
+func newCompactUpsertRequest(req *UpsertVectorsRequest) *compactUpsertRequest {
+	vectors := make([]compactVectorItem, len(req.Vectors))
+	for i, item := range req.Vectors {
+		vectors[i] = compactVectorItem(item)
+	}
+	return &compactUpsertRequest{Vectors: vectors, Namespace: req.Namespace}
+}
+
+// compactVectors reports whether the client sends compact vectors
+func (c *Client) compactVectors() bool {
+	return c.config.VectorEncoding == VectorEncodingBase64
+}
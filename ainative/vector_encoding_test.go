@@ -0,0 +1,131 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeVector(t *testing.T) {
+	v := []float32{1, -0.5, 0.25, 3.4028235e38}
+	encoded := EncodeVector(v)
+	assert.Equal(t, "AACAPwAAAL8AAIA+//9/fw==", encoded)
+
+	decoded, err := DecodeVector(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, v, decoded)
+
+	empty, err := DecodeVector("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	_, err = DecodeVector("not base64!")
+	assert.Error(t, err)
+	_, err = DecodeVector("AACA")
+	assert.Error(t, err)
+
+	assert.Equal(t, []float64{0.5, 2}, Float64s(Float32s([]float64{0.5, 2})))
+	assert.Nil(t, Float32s(nil))
+}
+
+func TestVectorItem_JSON(t *testing.T) {
+	var item VectorItem
+	require.NoError(t, json.Unmarshal([]byte(`{"id": "a", "vector": [0.5, 1], "metadata": {"k": "v"}}`), &item))
+	assert.Equal(t, []float64{0.5, 1}, item.Vector)
+	assert.Nil(t, item.Vector32)
+	assert.Equal(t, "v", item.Metadata["k"])
+
+	// Compact vectors decode into Vector32
+	encoded := EncodeVector([]float32{0.5, 1})
+	require.NoError(t, json.Unmarshal([]byte(`{"id": "b", "vector": "`+encoded+`"}`), &item))
+	assert.Equal(t, "b", item.ID)
+	assert.Nil(t, item.Vector)
+	assert.Equal(t, []float32{0.5, 1}, item.Vector32)
+	assert.Equal(t, []float64{0.5, 1}, item.Float64())
+
+	// Vector32 is sent when Vector is not set
+	data, err := json.Marshal(VectorItem{ID: "c", Vector32: []float32{0.1, 2}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": "c", "vector": [0.1, 2]}`, string(data))
+
+	data, err = compactVectorItem{ID: "d", Vector: []float64{0.5, 1}}.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": "d", "vector": "`+encoded+`"}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"id": "e", "vector": "AACA"}`), &item))
+}
+
+func TestGenerateResponse_JSON(t *testing.T) {
+	var resp GenerateResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"embeddings": [[0.5, 1]], "model": "m", "count": 1}`), &resp))
+	assert.Equal(t, [][]float64{{0.5, 1}}, resp.Embeddings)
+	assert.Nil(t, resp.Embeddings32)
+	assert.Equal(t, [][]float32{{0.5, 1}}, resp.Float32())
+	assert.Equal(t, "m", resp.Model)
+
+	encoded := EncodeVector([]float32{0.25, 2})
+	require.NoError(t, json.Unmarshal([]byte(`{"embeddings": ["`+encoded+`", [1]], "count": 2}`), &resp))
+	assert.Nil(t, resp.Embeddings)
+	assert.Equal(t, [][]float32{{0.25, 2}, {1}}, resp.Float32())
+	assert.Equal(t, 2, resp.Count)
+
+	data, err := json.Marshal(GenerateResponse{Embeddings32: [][]float32{{1}}, Count: 1})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"embeddings":[[1]]`)
+}
+
+func TestVectorsService_CompactEncoding(t *testing.T) {
+	encoded := EncodeVector([]float32{1, 0.5})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "base64", r.Header.Get(HeaderVectorEncoding))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/zerodb/projects/proj_123/vectors":
+			vectors := body["vectors"].([]interface{})
+			assert.Equal(t, encoded, vectors[0].(map[string]interface{})["vector"])
+			assert.Equal(t, encoded, vectors[1].(map[string]interface{})["vector"])
+			w.Write([]byte(`{"upserted_count": 2, "namespace": "default"}`))
+		case "/api/v1/zerodb/projects/proj_123/vectors/search":
+			assert.Equal(t, encoded, body["vector"])
+			w.Write([]byte(`{"matches": [{"id": "a", "score": 1, "vector": "` + encoded + `"}], "namespace": "default"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		APIKey:         "test-key",
+		BaseURL:        server.URL,
+		VectorEncoding: VectorEncodingBase64,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.ZeroDB.Vectors.Upsert(ctx, "proj_123", &UpsertVectorsRequest{
+		Vectors: []VectorItem{
+			{ID: "a", Vector: []float64{1, 0.5}},
+			{ID: "b", Vector32: []float32{1, 0.5}},
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := client.ZeroDB.Vectors.Search(ctx, "proj_123", &VectorSearchRequest{
+		Vector32:      []float32{1, 0.5},
+		IncludeValues: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Matches, 1)
+	assert.Equal(t, []float32{1, 0.5}, result.Matches[0].Vector32)
+	assert.Equal(t, []float64{1, 0.5}, result.Matches[0].Float64())
+}
@@ -0,0 +1,265 @@
+package ainative
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WebhookEventType identifies the kind of a webhook event
+type WebhookEventType string
+
+const (
+	WebhookSwarmStatusChanged WebhookEventType = "swarm.status_changed"
+	WebhookTaskCompleted      WebhookEventType = "task.completed"
+	WebhookTaskFailed         WebhookEventType = "task.failed"
+	WebhookMessageDelivered   WebhookEventType = "message.delivered"
+)
+
+// maxWebhookBodySize limits the size of webhook payloads
+const maxWebhookBodySize = 1 << 20
+
+// WebhookEvent is the envelope of a webhook delivery. Data holds the
+// type-specific payload.
+type WebhookEvent struct {
+	ID        string           `json:"id"`
+	Type      WebhookEventType `json:"type"`
+	CreatedAt time.Time        `json:"created_at"`
+	Data      json.RawMessage  `json:"data"`
+}
+
+// SwarmStatusChangedEvent is the payload of a swarm.status_changed event
+type SwarmStatusChangedEvent struct {
+	SwarmID        string      `json:"swarm_id"`
+	ProjectID      string      `json:"project_id,omitempty"`
+	PreviousStatus SwarmStatus `json:"previous_status,omitempty"`
+	Status         SwarmStatus `json:"status"`
+	Reason         string      `json:"reason,omitempty"`
+}
+
+// TaskEvent is the payload of task.completed and task.failed events
+type TaskEvent struct {
+	TaskID     string      `json:"task_id"`
+	SwarmID    string      `json:"swarm_id,omitempty"`
+	AgentID    string      `json:"agent_id,omitempty"`
+	Status     TaskStatus  `json:"status"`
+	Result     interface{} `json:"result,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	DurationMs int64       `json:"duration_ms,omitempty"`
+}
+
+// MessageDeliveredEvent is the payload of a message.delivered event
+type MessageDeliveredEvent struct {
+	Message AgentMessage `json:"message"`
+}
+
+// WebhookHandler is an http.Handler that receives AINative webhook
+// callbacks. It verifies each delivery's signature (see SignRequest), rejects
+// replays, decodes the event and dispatches it to the registered callbacks.
+//
+// The handler answers 401 for invalid signatures, 400 for malformed events
+// and 500 when a callback returns an error, so the delivery is retried. A
+// failed delivery's nonce is forgotten so its retry is not taken for a
+// replay, and the callback's error is logged rather than sent back.
+// Events without a registered callback are acknowledged and dropped.
+//
+// Signatures cover the request URI, so mount the handler at the path
+// configured for the webhook, without rewriting it.
+//
+// Example:
+//
+//	webhooks := ainative.NewWebhookHandler(os.Getenv("AINATIVE_WEBHOOK_SECRET"))
+//	webhooks.OnTaskCompleted(func(ctx context.Context, event *ainative.WebhookEvent, task *ainative.TaskEvent) error {
+//	    log.Printf("task %s completed", task.TaskID)
+//	    return nil
+//	})
+//	http.Handle("/webhooks/ainative", webhooks)
+type WebhookHandler struct {
+	verifier *RequestVerifier
+	logger   *slog.Logger
+
+	mu                 sync.RWMutex
+	swarmStatusChanged []func(ctx context.Context, event *WebhookEvent, data *SwarmStatusChangedEvent) error
+	taskCompleted      []func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error
+	taskFailed         []func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error
+	messageDelivered   []func(ctx context.Context, event *WebhookEvent, data *MessageDeliveredEvent) error
+	any                []func(ctx context.Context, event *WebhookEvent) error
+}
+
+// NewWebhookHandler creates a webhook handler that verifies deliveries with
+// the webhook signing secret
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		verifier: NewRequestVerifier(func(*http.Request) (string, error) {
+			if secret == "" {
+				return "", errors.New("no webhook secret configured")
+			}
+			return secret, nil
+		}),
+	}
+}
+
+// WithTolerance sets how far a delivery's timestamp may be from the local
+// clock (defaults to DefaultSignatureTolerance)
+func (h *WebhookHandler) WithTolerance(tolerance time.Duration) *WebhookHandler {
+	h.verifier.WithTolerance(tolerance)
+	return h
+}
+
+// WithLogger sets the logger for callback errors (defaults to slog.Default)
+func (h *WebhookHandler) WithLogger(logger *slog.Logger) *WebhookHandler {
+	h.logger = logger
+	return h
+}
+
+// OnSwarmStatusChanged registers a callback for swarm.status_changed events
+func (h *WebhookHandler) OnSwarmStatusChanged(fn func(ctx context.Context, event *WebhookEvent, data *SwarmStatusChangedEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.swarmStatusChanged = append(h.swarmStatusChanged, fn)
+}
+
+// OnTaskCompleted registers a callback for task.completed events
+func (h *WebhookHandler) OnTaskCompleted(fn func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.taskCompleted = append(h.taskCompleted, fn)
+}
+
+// OnTaskFailed registers a callback for task.failed events
+func (h *WebhookHandler) OnTaskFailed(fn func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.taskFailed = append(h.taskFailed, fn)
+}
+
+// OnMessageDelivered registers a callback for message.delivered events
+func (h *WebhookHandler) OnMessageDelivered(fn func(ctx context.Context, event *WebhookEvent, data *MessageDeliveredEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.messageDelivered = append(h.messageDelivered, fn)
+}
+
+// OnEvent registers a callback for every verified event, including types
+// this SDK does not know about. It runs before the typed callbacks.
+func (h *WebhookHandler) OnEvent(fn func(ctx context.Context, event *WebhookEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.any = append(h.any, fn)
+}
+
+// ServeHTTP implements http.Handler
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeErrorDetail(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxWebhookBodySize)
+	if err := h.verifier.Verify(r); err != nil {
+		writeErrorDetail(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var event WebhookEvent
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		writeErrorDetail(w, http.StatusBadRequest, fmt.Sprintf("invalid webhook event: %v", err))
+		return
+	}
+	if event.Type == "" {
+		writeErrorDetail(w, http.StatusBadRequest, "invalid webhook event: missing type")
+		return
+	}
+
+	if err := h.Dispatch(r.Context(), &event); err != nil {
+		var decodeErr *webhookDecodeError
+		if errors.As(err, &decodeErr) {
+			writeErrorDetail(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.verifier.forget(r)
+
+		logger := h.logger
+		if logger == nil {
+			logger = slog.Default()
+		}
+		logger.ErrorContext(r.Context(), "ainative webhook callback failed",
+			slog.String("event_id", event.ID),
+			slog.String("event_type", string(event.Type)),
+			slog.String("error", err.Error()),
+		)
+		writeErrorDetail(w, http.StatusInternalServerError, "webhook event could not be processed")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Dispatch decodes an already verified event and runs its callbacks in
+// registration order, stopping at the first error
+func (h *WebhookHandler) Dispatch(ctx context.Context, event *WebhookEvent) error {
+	h.mu.RLock()
+	anyFns := h.any
+	swarmFns := h.swarmStatusChanged
+	completedFns := h.taskCompleted
+	failedFns := h.taskFailed
+	messageFns := h.messageDelivered
+	h.mu.RUnlock()
+
+	for _, fn := range anyFns {
+		if err := fn(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	switch event.Type {
+	case WebhookSwarmStatusChanged:
+		return dispatchWebhook(ctx, event, swarmFns)
+	case WebhookTaskCompleted:
+		return dispatchWebhook(ctx, event, completedFns)
+	case WebhookTaskFailed:
+		return dispatchWebhook(ctx, event, failedFns)
+	case WebhookMessageDelivered:
+		return dispatchWebhook(ctx, event, messageFns)
+	}
+	return nil
+}
+
+// dispatchWebhook decodes the event data into T and runs fns
+func dispatchWebhook[T any](ctx context.Context, event *WebhookEvent, fns []func(context.Context, *WebhookEvent, *T) error) error {
+	if len(fns) == 0 {
+		return nil
+	}
+
+	data := new(T)
+	if err := json.Unmarshal(event.Data, data); err != nil {
+		return &webhookDecodeError{eventType: event.Type, err: err}
+	}
+
+	for _, fn := range fns {
+		if err := fn(ctx, event, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// webhookDecodeError reports event data that does not match its type
+type webhookDecodeError struct {
+	eventType WebhookEventType
+	err       error
+}
+
+func (e *webhookDecodeError) Error() string {
+	return fmt.Sprintf("invalid %s event data: %v", e.eventType, e.err)
+}
+
+func (e *webhookDecodeError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,202 @@
+package ainative
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWebhookDelivery returns a webhook delivery signed with secret
+func newWebhookDelivery(t *testing.T, body string, secret string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/ainative", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	require.NoError(t, SignRequest(req, secret))
+	return req
+}
+
+func TestWebhookHandler_Dispatch(t *testing.T) {
+	handler := NewWebhookHandler("whsec")
+
+	var (
+		swarm     *SwarmStatusChangedEvent
+		completed *TaskEvent
+		failed    *TaskEvent
+		message   *MessageDeliveredEvent
+		seen      []WebhookEventType
+	)
+	handler.OnEvent(func(ctx context.Context, event *WebhookEvent) error {
+		seen = append(seen, event.Type)
+		return nil
+	})
+	handler.OnSwarmStatusChanged(func(ctx context.Context, event *WebhookEvent, data *SwarmStatusChangedEvent) error {
+		swarm = data
+		return nil
+	})
+	handler.OnTaskCompleted(func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error {
+		completed = data
+		return nil
+	})
+	handler.OnTaskFailed(func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error {
+		failed = data
+		return nil
+	})
+	handler.OnMessageDelivered(func(ctx context.Context, event *WebhookEvent, data *MessageDeliveredEvent) error {
+		message = data
+		return nil
+	})
+
+	deliveries := []string{
+		`{"id": "evt_1", "type": "swarm.status_changed", "created_at": "2025-01-01T00:00:00Z",
+		  "data": {"swarm_id": "swarm_1", "previous_status": "running", "status": "completed"}}`,
+		`{"id": "evt_2", "type": "task.completed", "data": {"task_id": "task_1", "status": "completed", "result": {"ok": true}}}`,
+		`{"id": "evt_3", "type": "task.failed", "data": {"task_id": "task_2", "status": "failed", "error": "timeout"}}`,
+		`{"id": "evt_4", "type": "message.delivered", "data": {"message": {"id": "msg_1", "from_agent": "a", "to_agent": "b", "message": "hi"}}}`,
+		`{"id": "evt_5", "type": "project.created", "data": {}}`,
+	}
+	for _, body := range deliveries {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newWebhookDelivery(t, body, "whsec"))
+		assert.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	}
+
+	require.NotNil(t, swarm)
+	assert.Equal(t, "swarm_1", swarm.SwarmID)
+	assert.Equal(t, SwarmStatusRunning, swarm.PreviousStatus)
+	assert.Equal(t, SwarmStatusCompleted, swarm.Status)
+
+	require.NotNil(t, completed)
+	assert.Equal(t, "task_1", completed.TaskID)
+	assert.Equal(t, map[string]interface{}{"ok": true}, completed.Result)
+
+	require.NotNil(t, failed)
+	assert.Equal(t, TaskStatusFailed, failed.Status)
+	assert.Equal(t, "timeout", failed.Error)
+
+	require.NotNil(t, message)
+	assert.Equal(t, "msg_1", message.Message.ID)
+
+	assert.Equal(t, []WebhookEventType{
+		WebhookSwarmStatusChanged, WebhookTaskCompleted, WebhookTaskFailed, WebhookMessageDelivered, "project.created",
+	}, seen)
+}
+
+func TestWebhookHandler_Rejections(t *testing.T) {
+	var logs bytes.Buffer
+	handler := NewWebhookHandler("whsec").WithLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+	handler.OnTaskCompleted(func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error {
+		if data.TaskID == "retry" {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	tests := []struct {
+		name   string
+		req    func() *http.Request
+		status int
+		detail string
+	}{
+		{"wrong secret", func() *http.Request {
+			return newWebhookDelivery(t, `{"type": "task.completed", "data": {}}`, "other")
+		}, http.StatusUnauthorized, "signature mismatch"},
+		{"unsigned", func() *http.Request {
+			return httptest.NewRequest(http.MethodPost, "/webhooks/ainative", strings.NewReader(`{}`))
+		}, http.StatusUnauthorized, "missing signature headers"},
+		{"wrong method", func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, "/webhooks/ainative", nil)
+		}, http.StatusMethodNotAllowed, "method not allowed"},
+		{"malformed envelope", func() *http.Request {
+			return newWebhookDelivery(t, `not json`, "whsec")
+		}, http.StatusBadRequest, "invalid webhook event"},
+		{"missing type", func() *http.Request {
+			return newWebhookDelivery(t, `{"data": {}}`, "whsec")
+		}, http.StatusBadRequest, "missing type"},
+		{"malformed data", func() *http.Request {
+			return newWebhookDelivery(t, `{"type": "task.completed", "data": {"task_id": 7}}`, "whsec")
+		}, http.StatusBadRequest, "invalid task.completed event data"},
+		{"callback error", func() *http.Request {
+			return newWebhookDelivery(t, `{"type": "task.completed", "data": {"task_id": "retry"}}`, "whsec")
+		}, http.StatusInternalServerError, "webhook event could not be processed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, tt.req())
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.detail)
+		})
+	}
+
+	// Callback errors are logged, not sent to the sender
+	assert.Contains(t, logs.String(), "database unavailable")
+}
+
+func TestWebhookHandler_RejectsReplays(t *testing.T) {
+	var calls int
+	handler := NewWebhookHandler("whsec").WithTolerance(time.Minute)
+	handler.OnTaskCompleted(func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error {
+		calls++
+		return nil
+	})
+
+	body := `{"type": "task.completed", "data": {"task_id": "task_1"}}`
+	req := newWebhookDelivery(t, body, "whsec")
+	replay := httptest.NewRequest(http.MethodPost, "/webhooks/ainative", strings.NewReader(body))
+	replay.Header = req.Header.Clone()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, replay)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "replayed nonce")
+	assert.Equal(t, 1, calls)
+}
+
+func TestWebhookHandler_RetryAfterCallbackError(t *testing.T) {
+	var calls int
+	handler := NewWebhookHandler("whsec").WithLogger(slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil)))
+	handler.OnTaskCompleted(func(ctx context.Context, event *WebhookEvent, data *TaskEvent) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	body := `{"id": "evt_1", "type": "task.completed", "data": {"task_id": "task_1"}}`
+	req := newWebhookDelivery(t, body, "whsec")
+	retry := httptest.NewRequest(http.MethodPost, "/webhooks/ainative", strings.NewReader(body))
+	retry.Header = req.Header.Clone()
+	replay := httptest.NewRequest(http.MethodPost, "/webhooks/ainative", strings.NewReader(body))
+	replay.Header = req.Header.Clone()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "database unavailable")
+
+	// The sender's retry of the failed delivery is processed
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, retry)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, 2, calls)
+
+	// Once processed, the delivery is a replay
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, replay)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 2, calls)
+}
@@ -2,15 +2,18 @@ package ainative
 
 import (
 	"context"
-	"fmt"
 	"time"
+
+	"github.com/ainative/go-sdk/ainative/filter"
 )
 
-// ZeroDBService handles ZeroDB operations
+// ZeroDBService handles ZeroDB operations. Methods taking a projectID use the
+// client's scoped project (see Client.WithProject) when it is empty.
 type ZeroDBService struct {
 	client     *Client
 	Projects   *ProjectsService
 	Vectors    *VectorsService
+	Namespaces *NamespacesService
 	Memory     *MemoryService
 	Embeddings *EmbeddingsService
 }
@@ -23,6 +26,7 @@ func NewZeroDBService(client *Client) *ZeroDBService {
 
 	service.Projects = &ProjectsService{client: client}
 	service.Vectors = &VectorsService{client: client}
+	service.Namespaces = &NamespacesService{client: client}
 	service.Memory = &MemoryService{client: client}
 	service.Embeddings = &EmbeddingsService{client: client}
 
@@ -89,6 +93,7 @@ type UpdateProjectRequest struct {
 type ListProjectsRequest struct {
 	Limit  int           `json:"limit,omitempty"`
 	Offset int           `json:"offset,omitempty"`
+	Cursor string        `json:"cursor,omitempty"`
 	Status ProjectStatus `json:"status,omitempty"`
 }
 
@@ -98,6 +103,7 @@ type ListProjectsResponse struct {
 	TotalCount int       `json:"total_count"`
 	Limit      int       `json:"limit"`
 	Offset     int       `json:"offset"`
+	NextCursor string    `json:"next_cursor,omitempty"`
 }
 
 // Create creates a new project
@@ -112,7 +118,7 @@ func (s *ProjectsService) Create(ctx context.Context, req *CreateProjectRequest)
 	
 	var result Project
 	
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/zerodb/projects", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/zerodb/projects"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -133,10 +139,11 @@ func (s *ProjectsService) List(ctx context.Context, req *ListProjectsRequest) (*
 	
 	var result ListProjectsResponse
 	
-	path := fmt.Sprintf("/api/v1/zerodb/projects?limit=%d&offset=%d", req.Limit, req.Offset)
-	if req.Status != "" {
-		path += fmt.Sprintf("&status=%s", req.Status)
-	}
+	path := endpoint("/api/v1/zerodb/projects").
+		QueryInt("limit", req.Limit).
+		QueryInt("offset", req.Offset).
+		Query("cursor", req.Cursor).
+		Query("status", string(req.Status))
 	
 	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
 	if err != nil {
@@ -146,15 +153,41 @@ func (s *ProjectsService) List(ctx context.Context, req *ListProjectsRequest) (*
 	return &result, nil
 }
 
+// ListAll returns a Pager over every project matching req, fetching req.Limit
+// projects per page
+func (s *ProjectsService) ListAll(ctx context.Context, req *ListProjectsRequest) *Pager[Project] {
+	query := ListProjectsRequest{}
+	if req != nil {
+		query = *req
+	}
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+	
+	return newPager(ctx, query.Limit, query.Offset, func(ctx context.Context, cursor string, offset int) (*Page[Project], error) {
+		page := query
+		page.Cursor = cursor
+		page.Offset = offset
+		
+		result, err := s.List(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+		
+		return &Page[Project]{Items: result.Projects, NextCursor: result.NextCursor, Total: result.TotalCount}, nil
+	})
+}
+
 // Get retrieves a specific project
 func (s *ProjectsService) Get(ctx context.Context, projectID string) (*Project, error) {
+	projectID = s.client.projectID(projectID)
 	if projectID == "" {
 		return nil, NewValidationError("project_id", "project ID is required", projectID)
 	}
 	
 	var result Project
 	
-	path := fmt.Sprintf("/api/v1/zerodb/projects/%s", projectID)
+	path := endpoint("/api/v1/zerodb/projects/%s", projectID)
 	
 	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
 	if err != nil {
@@ -166,6 +199,7 @@ func (s *ProjectsService) Get(ctx context.Context, projectID string) (*Project,
 
 // Update updates a project
 func (s *ProjectsService) Update(ctx context.Context, projectID string, req *UpdateProjectRequest) (*Project, error) {
+	projectID = s.client.projectID(projectID)
 	if projectID == "" {
 		return nil, NewValidationError("project_id", "project ID is required", projectID)
 	}
@@ -176,7 +210,7 @@ func (s *ProjectsService) Update(ctx context.Context, projectID string, req *Upd
 	
 	var result Project
 	
-	path := fmt.Sprintf("/api/v1/zerodb/projects/%s", projectID)
+	path := endpoint("/api/v1/zerodb/projects/%s", projectID)
 	
 	err := s.client.makeRequest(ctx, "PUT", path, req, &result)
 	if err != nil {
@@ -186,7 +220,8 @@ func (s *ProjectsService) Update(ctx context.Context, projectID string, req *Upd
 	return &result, nil
 }
 
-// Suspend suspends a project
+// Suspend suspends a project. Unlike most ZeroDB methods, it never falls
+// back to the client's scoped project, so projectID is required.
 func (s *ProjectsService) Suspend(ctx context.Context, projectID string, reason string) error {
 	if projectID == "" {
 		return NewValidationError("project_id", "project ID is required", projectID)
@@ -196,29 +231,31 @@ func (s *ProjectsService) Suspend(ctx context.Context, projectID string, reason
 		"reason": reason,
 	}
 	
-	path := fmt.Sprintf("/api/v1/zerodb/projects/%s/suspend", projectID)
+	path := endpoint("/api/v1/zerodb/projects/%s/suspend", projectID)
 	
 	return s.client.makeRequest(ctx, "POST", path, req, nil)
 }
 
 // Activate activates a suspended project
 func (s *ProjectsService) Activate(ctx context.Context, projectID string) error {
+	projectID = s.client.projectID(projectID)
 	if projectID == "" {
 		return NewValidationError("project_id", "project ID is required", projectID)
 	}
 	
-	path := fmt.Sprintf("/api/v1/zerodb/projects/%s/activate", projectID)
+	path := endpoint("/api/v1/zerodb/projects/%s/activate", projectID)
 	
 	return s.client.makeRequest(ctx, "POST", path, nil, nil)
 }
 
-// Delete deletes a project
+// Delete deletes a project. Unlike most ZeroDB methods, it never falls back
+// to the client's scoped project, so projectID is required.
 func (s *ProjectsService) Delete(ctx context.Context, projectID string) error {
 	if projectID == "" {
 		return NewValidationError("project_id", "project ID is required", projectID)
 	}
 	
-	path := fmt.Sprintf("/api/v1/zerodb/projects/%s", projectID)
+	path := endpoint("/api/v1/zerodb/projects/%s", projectID)
 	
 	return s.client.makeRequest(ctx, "DELETE", path, nil, nil)
 }
@@ -228,19 +265,22 @@ type VectorsService struct {
 	client *Client
 }
 
-// VectorItem represents a vector with metadata
+// VectorItem represents a vector with metadata. Set either Vector or
+// Vector32; compact vectors from the server are decoded into Vector32.
 type VectorItem struct {
 	ID       string                 `json:"id"`
 	Vector   []float64              `json:"vector"`
+	Vector32 []float32              `json:"-"` // used when Vector is nil
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // VectorSearchRequest represents a vector search request
 type VectorSearchRequest struct {
 	Vector    []float64              `json:"vector"`
+	Vector32  []float32              `json:"-"` // used when Vector is nil
 	TopK      int                    `json:"top_k"`
 	Namespace string                 `json:"namespace,omitempty"`
-	Filter    map[string]interface{} `json:"filter,omitempty"`
+	Filter    map[string]interface{} `json:"filter,omitempty"` // build with package filter, e.g. filter.Eq("category", "ml").Map()
 	IncludeMetadata bool              `json:"include_metadata"`
 	IncludeValues   bool              `json:"include_values"`
 }
@@ -250,6 +290,7 @@ type VectorSearchMatch struct {
 	ID       string                 `json:"id"`
 	Score    float64               `json:"score"`
 	Vector   []float64              `json:"vector,omitempty"`
+	Vector32 []float32              `json:"-"` // set instead of Vector for compact vectors
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
@@ -273,6 +314,7 @@ type UpsertVectorsResponse struct {
 
 // Search searches for similar vectors
 func (s *VectorsService) Search(ctx context.Context, projectID string, req *VectorSearchRequest) (*VectorSearchResponse, error) {
+	projectID = s.client.projectID(projectID)
 	if projectID == "" {
 		return nil, NewValidationError("project_id", "project ID is required", projectID)
 	}
@@ -281,7 +323,7 @@ func (s *VectorsService) Search(ctx context.Context, projectID string, req *Vect
 		return nil, NewValidationError("request", "request cannot be nil", nil)
 	}
 	
-	if len(req.Vector) == 0 {
+	if len(req.Vector) == 0 && len(req.Vector32) == 0 {
 		return nil, NewValidationError("vector", "vector cannot be empty", req.Vector)
 	}
 	
@@ -289,11 +331,20 @@ func (s *VectorsService) Search(ctx context.Context, projectID string, req *Vect
 		req.TopK = 5
 	}
 	
+	if err := validateFilter(req.Filter); err != nil {
+		return nil, err
+	}
+	
 	var result VectorSearchResponse
 	
-	path := fmt.Sprintf("/api/v1/zerodb/projects/%s/vectors/search", projectID)
+	path := endpoint("/api/v1/zerodb/projects/%s/vectors/search", projectID)
 	
-	err := s.client.makeRequest(ctx, "POST", path, req, &result)
+	var body interface{} = req
+	if s.client.compactVectors() {
+		body = compactSearchRequest(*req)
+	}
+	
+	err := s.client.makeRequest(ctx, "POST", path, body, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -303,6 +354,7 @@ func (s *VectorsService) Search(ctx context.Context, projectID string, req *Vect
 
 // Upsert upserts vectors into the project
 func (s *VectorsService) Upsert(ctx context.Context, projectID string, req *UpsertVectorsRequest) (*UpsertVectorsResponse, error) {
+	projectID = s.client.projectID(projectID)
 	if projectID == "" {
 		return nil, NewValidationError("project_id", "project ID is required", projectID)
 	}
@@ -317,7 +369,144 @@ func (s *VectorsService) Upsert(ctx context.Context, projectID string, req *Upse
 	
 	var result UpsertVectorsResponse
 	
-	path := fmt.Sprintf("/api/v1/zerodb/projects/%s/vectors", projectID)
+	path := endpoint("/api/v1/zerodb/projects/%s/vectors", projectID)
+	
+	var body interface{} = req
+	if s.client.compactVectors() {
+		body = newCompactUpsertRequest(req)
+	}
+	
+	err := s.client.makeRequest(ctx, "POST", path, body, &result)
+	if err != nil {
+		return nil, err
+	}
+	
+	return &result, nil
+}
+
+// FetchVectorsRequest represents a request to fetch vectors by ID
+type FetchVectorsRequest struct {
+	IDs       []string `json:"ids"`
+	Namespace string   `json:"namespace,omitempty"`
+}
+
+// FetchVectorsResponse represents the vectors found by a fetch. IDs that do
+// not exist are omitted.
+type FetchVectorsResponse struct {
+	Vectors   []VectorItem `json:"vectors"`
+	Namespace string       `json:"namespace"`
+}
+
+// DeleteVectorsRequest represents a request to delete vectors, either by ID
+// or by metadata filter
+type DeleteVectorsRequest struct {
+	IDs       []string               `json:"ids,omitempty"`
+	Filter    map[string]interface{} `json:"filter,omitempty"`
+	Namespace string                 `json:"namespace,omitempty"`
+}
+
+// DeleteVectorsResponse represents a response from deleting vectors
+type DeleteVectorsResponse struct {
+	DeletedCount int    `json:"deleted_count"`
+	Namespace    string `json:"namespace"`
+}
+
+// UpdateVectorMetadataRequest represents a request to update a vector's
+// metadata. Keys are merged into the existing metadata unless Replace is set.
+type UpdateVectorMetadataRequest struct {
+	Metadata  map[string]interface{} `json:"metadata"`
+	Namespace string                 `json:"namespace,omitempty"`
+	Replace   bool                   `json:"replace,omitempty"`
+}
+
+// ListVectorIDsRequest represents a request to list vector IDs
+type ListVectorIDsRequest struct {
+	Namespace string `json:"namespace,omitempty"`
+	Prefix    string `json:"prefix,omitempty"`
+	Limit     int    `json:"limit,omitempty"`
+	Offset    int    `json:"offset,omitempty"`
+	Cursor    string `json:"cursor,omitempty"`
+}
+
+// ListVectorIDsResponse represents a page of vector IDs
+type ListVectorIDsResponse struct {
+	IDs        []string `json:"ids"`
+	Namespace  string   `json:"namespace"`
+	TotalCount int      `json:"total_count"`
+	Limit      int      `json:"limit"`
+	Offset     int      `json:"offset"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+// NamespaceStats represents the contents of a vector namespace
+type NamespaceStats struct {
+	VectorCount int64 `json:"vector_count"`
+	Dimension   int   `json:"dimension"`
+}
+
+// VectorStats represents vector index statistics for a project, or for a
+// single namespace when one was requested
+type VectorStats struct {
+	TotalVectorCount int64                     `json:"total_vector_count"`
+	Dimension        int                       `json:"dimension"`
+	Namespaces       map[string]NamespaceStats `json:"namespaces"`
+}
+
+// Fetch retrieves vectors by ID
+func (s *VectorsService) Fetch(ctx context.Context, projectID string, req *FetchVectorsRequest) (*FetchVectorsResponse, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+	
+	if req == nil {
+		return nil, NewValidationError("request", "request cannot be nil", nil)
+	}
+	
+	if len(req.IDs) == 0 {
+		return nil, NewValidationError("ids", "ids cannot be empty", req.IDs)
+	}
+	
+	var result FetchVectorsResponse
+	
+	path := endpoint("/api/v1/zerodb/projects/%s/vectors/fetch", projectID)
+	
+	err := s.client.makeRequest(ctx, "POST", path, req, &result)
+	if err != nil {
+		return nil, err
+	}
+	
+	return &result, nil
+}
+
+// Delete deletes vectors by ID or by metadata filter. Exactly one of IDs and
+// Filter must be set, so a request can never delete a whole namespace by
+// accident.
+func (s *VectorsService) Delete(ctx context.Context, projectID string, req *DeleteVectorsRequest) (*DeleteVectorsResponse, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+	
+	if req == nil {
+		return nil, NewValidationError("request", "request cannot be nil", nil)
+	}
+	
+	if len(req.IDs) == 0 && len(req.Filter) == 0 {
+		return nil, NewValidationError("ids", "either ids or filter is required", req.IDs)
+	}
+	
+	if len(req.IDs) > 0 && len(req.Filter) > 0 {
+		return nil, NewValidationError("filter", "ids and filter cannot be combined", req.Filter)
+	}
+	
+	if err := validateFilter(req.Filter); err != nil {
+		return nil, err
+	}
+	
+	var result DeleteVectorsResponse
+	
+	path := endpoint("/api/v1/zerodb/projects/%s/vectors/delete", projectID)
 	
 	err := s.client.makeRequest(ctx, "POST", path, req, &result)
 	if err != nil {
@@ -327,6 +516,126 @@ func (s *VectorsService) Upsert(ctx context.Context, projectID string, req *Upse
 	return &result, nil
 }
 
+// UpdateMetadata updates the metadata of a vector and returns the vector
+func (s *VectorsService) UpdateMetadata(ctx context.Context, projectID, vectorID string, req *UpdateVectorMetadataRequest) (*VectorItem, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+	
+	if vectorID == "" {
+		return nil, NewValidationError("vector_id", "vector ID is required", vectorID)
+	}
+	
+	if req == nil {
+		return nil, NewValidationError("request", "request cannot be nil", nil)
+	}
+	
+	if req.Metadata == nil && !req.Replace {
+		return nil, NewValidationError("metadata", "metadata is required", req.Metadata)
+	}
+	
+	var result VectorItem
+	
+	path := endpoint("/api/v1/zerodb/projects/%s/vectors/%s/metadata", projectID, vectorID)
+	
+	err := s.client.makeRequest(ctx, "PATCH", path, req, &result)
+	if err != nil {
+		return nil, err
+	}
+	
+	return &result, nil
+}
+
+// ListIDs lists the IDs of stored vectors, optionally only those starting
+// with req.Prefix
+func (s *VectorsService) ListIDs(ctx context.Context, projectID string, req *ListVectorIDsRequest) (*ListVectorIDsResponse, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+	
+	if req == nil {
+		req = &ListVectorIDsRequest{}
+	}
+	
+	// Set defaults
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+	
+	var result ListVectorIDsResponse
+	
+	path := endpoint("/api/v1/zerodb/projects/%s/vectors/ids", projectID).
+		Query("namespace", req.Namespace).
+		Query("prefix", req.Prefix).
+		QueryInt("limit", req.Limit).
+		QueryInt("offset", req.Offset).
+		Query("cursor", req.Cursor)
+	
+	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	
+	return &result, nil
+}
+
+// ListAllIDs returns a Pager over every vector ID matching req, fetching
+// req.Limit IDs per page
+func (s *VectorsService) ListAllIDs(ctx context.Context, projectID string, req *ListVectorIDsRequest) *Pager[string] {
+	query := ListVectorIDsRequest{}
+	if req != nil {
+		query = *req
+	}
+	if query.Limit == 0 {
+		query.Limit = 100
+	}
+	
+	return newPager(ctx, query.Limit, query.Offset, func(ctx context.Context, cursor string, offset int) (*Page[string], error) {
+		page := query
+		page.Cursor = cursor
+		page.Offset = offset
+		
+		result, err := s.ListIDs(ctx, projectID, &page)
+		if err != nil {
+			return nil, err
+		}
+		
+		return &Page[string]{Items: result.IDs, NextCursor: result.NextCursor, Total: result.TotalCount}, nil
+	})
+}
+
+// GetStats returns vector counts and dimensions for the project, limited to
+// namespace when it is not empty
+func (s *VectorsService) GetStats(ctx context.Context, projectID, namespace string) (*VectorStats, error) {
+	projectID = s.client.projectID(projectID)
+	if projectID == "" {
+		return nil, NewValidationError("project_id", "project ID is required", projectID)
+	}
+	
+	var result VectorStats
+	
+	path := endpoint("/api/v1/zerodb/projects/%s/vectors/stats", projectID).
+		Query("namespace", namespace)
+	
+	err := s.client.makeRequest(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	
+	return &result, nil
+}
+
+// validateFilter checks the operators and operand types of a metadata filter
+// document before it is sent
+func validateFilter(doc map[string]interface{}) error {
+	if err := filter.Check(doc); err != nil {
+		return NewValidationError("filter", err.Error(), doc)
+	}
+	return nil
+}
+
 // MemoryService handles memory operations
 type MemoryService struct {
 	client *Client
@@ -394,7 +703,7 @@ func (s *MemoryService) Create(ctx context.Context, req *CreateMemoryRequest) (*
 	
 	var result MemoryItem
 	
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/memory", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/memory"), req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -418,7 +727,7 @@ func (s *MemoryService) Search(ctx context.Context, req *SearchMemoryRequest) (*
 	
 	var result SearchMemoryResponse
 	
-	err := s.client.makeRequest(ctx, "POST", "/api/v1/memory/search", req, &result)
+	err := s.client.makeRequest(ctx, "POST", endpoint("/api/v1/memory/search"), req, &result)
 	if err != nil {
 		return nil, err
 	}
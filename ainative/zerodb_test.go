@@ -3,13 +3,17 @@ package ainative
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ainative/go-sdk/ainative/filter"
 )
 
 func TestProjectsService_Create(t *testing.T) {
@@ -448,4 +452,219 @@ func TestMemoryService_Validation(t *testing.T) {
 	_, err = client.ZeroDB.Memory.Search(ctx, &SearchMemoryRequest{Query: ""})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "query is required")
-}
\ No newline at end of file
+}
+func TestVectorsService_FetchAndDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/zerodb/projects/proj_123/vectors/fetch":
+			var req FetchVectorsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, []string{"vec_1", "vec_missing"}, req.IDs)
+
+			json.NewEncoder(w).Encode(FetchVectorsResponse{
+				Vectors:   []VectorItem{{ID: "vec_1", Vector: []float64{0.1, 0.2}}},
+				Namespace: "default",
+			})
+		case "/api/v1/zerodb/projects/proj_123/vectors/delete":
+			var req DeleteVectorsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Empty(t, req.IDs)
+			assert.Equal(t, map[string]interface{}{"source": "stale"}, req.Filter)
+			assert.Equal(t, "docs", req.Namespace)
+
+			json.NewEncoder(w).Encode(DeleteVectorsResponse{DeletedCount: 3, Namespace: "docs"})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	fetched, err := client.ZeroDB.Vectors.Fetch(ctx, "proj_123", &FetchVectorsRequest{IDs: []string{"vec_1", "vec_missing"}})
+	require.NoError(t, err)
+	require.Len(t, fetched.Vectors, 1)
+	assert.Equal(t, "vec_1", fetched.Vectors[0].ID)
+
+	deleted, err := client.ZeroDB.Vectors.Delete(ctx, "proj_123", &DeleteVectorsRequest{
+		Filter:    map[string]interface{}{"source": "stale"},
+		Namespace: "docs",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, deleted.DeletedCount)
+}
+
+func TestVectorsService_UpdateMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/zerodb/projects/proj_123/vectors/doc%2F1/metadata", r.URL.EscapedPath())
+		assert.Equal(t, "PATCH", r.Method)
+
+		var req UpdateVectorMetadataRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]interface{}{"version": float64(2)}, req.Metadata)
+		assert.False(t, req.Replace)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(VectorItem{
+			ID:       "doc/1",
+			Metadata: map[string]interface{}{"source": "wiki", "version": 2},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	vector, err := client.ZeroDB.Vectors.UpdateMetadata(context.Background(), "proj_123", "doc/1", &UpdateVectorMetadataRequest{
+		Metadata: map[string]interface{}{"version": 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "wiki", vector.Metadata["source"])
+}
+
+func TestVectorsService_ListAllIDs(t *testing.T) {
+	ids := []string{"doc_1", "doc_2", "doc_3"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/zerodb/projects/proj_123/vectors/ids", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "doc_", r.URL.Query().Get("prefix"))
+		assert.Equal(t, "docs", r.URL.Query().Get("namespace"))
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := offset + 2
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ListVectorIDsResponse{
+			IDs:        ids[offset:end],
+			Namespace:  "docs",
+			TotalCount: len(ids),
+			Limit:      2,
+			Offset:     offset,
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	all, err := client.ZeroDB.Vectors.ListAllIDs(context.Background(), "proj_123", &ListVectorIDsRequest{
+		Namespace: "docs",
+		Prefix:    "doc_",
+		Limit:     2,
+	}).Collect()
+	require.NoError(t, err)
+	assert.Equal(t, ids, all)
+}
+
+func TestVectorsService_GetStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/zerodb/projects/proj_123/vectors/stats", r.URL.Path)
+		assert.Equal(t, "docs", r.URL.Query().Get("namespace"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"total_vector_count": 42, "dimension": 1536, "namespaces": {"docs": {"vector_count": 42, "dimension": 1536}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	stats, err := client.ZeroDB.Vectors.GetStats(context.Background(), "proj_123", "docs")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), stats.TotalVectorCount)
+	assert.Equal(t, 1536, stats.Dimension)
+	assert.Equal(t, NamespaceStats{VectorCount: 42, Dimension: 1536}, stats.Namespaces["docs"])
+}
+
+func TestVectorsService_CRUDValidation(t *testing.T) {
+	client, err := NewClient(&Config{APIKey: "test-key"})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = client.ZeroDB.Vectors.Fetch(ctx, "proj_123", &FetchVectorsRequest{})
+	assert.Contains(t, err.Error(), "ids cannot be empty")
+
+	_, err = client.ZeroDB.Vectors.Delete(ctx, "proj_123", &DeleteVectorsRequest{Namespace: "docs"})
+	assert.Contains(t, err.Error(), "either ids or filter is required")
+
+	_, err = client.ZeroDB.Vectors.Delete(ctx, "proj_123", &DeleteVectorsRequest{
+		IDs:    []string{"vec_1"},
+		Filter: map[string]interface{}{"source": "stale"},
+	})
+	assert.Contains(t, err.Error(), "ids and filter cannot be combined")
+
+	_, err = client.ZeroDB.Vectors.UpdateMetadata(ctx, "proj_123", "", &UpdateVectorMetadataRequest{})
+	assert.Contains(t, err.Error(), "vector ID is required")
+
+	_, err = client.ZeroDB.Vectors.UpdateMetadata(ctx, "proj_123", "vec_1", &UpdateVectorMetadataRequest{})
+	assert.Contains(t, err.Error(), "metadata is required")
+
+	_, err = client.ZeroDB.Vectors.GetStats(ctx, "", "")
+	assert.Contains(t, err.Error(), "project ID is required")
+
+	_, err = client.ZeroDB.Vectors.Search(ctx, "proj_123", &VectorSearchRequest{
+		Vector: []float64{0.1},
+		Filter: map[string]interface{}{"lang": map[string]interface{}{"$in": []string{}}},
+	})
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "filter", validationErr.Field)
+	assert.Contains(t, err.Error(), `$in on "lang" requires a non-empty list`)
+
+	_, err = client.ZeroDB.Vectors.Delete(ctx, "proj_123", &DeleteVectorsRequest{
+		Filter: filter.Gt("year", true).Map(),
+	})
+	assert.True(t, errors.Is(err, ErrValidation))
+}
+
+func TestVectorsService_RawFilters(t *testing.T) {
+	// Filters outside the typed operator set reach the server unchanged
+	raw := []map[string]interface{}{
+		{"title": map[string]interface{}{"$regex": "^intro"}},
+		{"tags": map[string]interface{}{"$all": []interface{}{"go", "db"}}},
+		{"$nor": []interface{}{map[string]interface{}{"draft": true}}},
+		{"year": map[string]interface{}{"$not": map[string]interface{}{"$lt": 2020.0}}},
+		{"tags": []interface{}{"go", "db"}},
+		{"author": map[string]interface{}{"name": "ada", "email": "ada@example.com"}},
+		{"year": map[string]interface{}{"$gte": 2020.0, "$mod": []interface{}{4.0, 0.0}}},
+	}
+
+	var received []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Filter map[string]interface{} `json:"filter"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		received = append(received, body.Filter)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"matches": [], "deleted_count": 0}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{APIKey: "test-key", BaseURL: server.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, doc := range raw {
+		_, err := client.ZeroDB.Vectors.Search(ctx, "proj_123", &VectorSearchRequest{Vector: []float64{0.1}, Filter: doc})
+		require.NoError(t, err, "%v", doc)
+		_, err = client.ZeroDB.Vectors.Delete(ctx, "proj_123", &DeleteVectorsRequest{Filter: doc})
+		require.NoError(t, err, "%v", doc)
+	}
+
+	require.Len(t, received, 2*len(raw))
+	for i, doc := range raw {
+		assert.Equal(t, doc, received[2*i])
+		assert.Equal(t, doc, received[2*i+1])
+	}
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"os"
 
+	"github.com/ainative/go-sdk/ainative"
 	"github.com/spf13/cobra"
 )
 
@@ -16,31 +19,59 @@ var configShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Show current configuration",
 	Run: func(cmd *cobra.Command, args []string) {
+		// Show whatever was loaded, even if it does not validate
+		config, err := loadConfig()
+		if config == nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
 		fmt.Println("Current Configuration:")
 		fmt.Println("=====================")
 
-		if apiKey != "" {
-			fmt.Printf("API Key:          %s\n", maskString(apiKey))
+		if profile != "" {
+			fmt.Printf("Profile:          %s\n", profile)
+		} else if env := os.Getenv(ainative.EnvProfile); env != "" {
+			fmt.Printf("Profile:          %s\n", env)
+		}
+
+		if config.APIKey != "" {
+			fmt.Printf("API Key:          %s\n", maskString(config.APIKey))
 		} else {
 			fmt.Println("API Key:          Not set")
 		}
 
-		if apiSecret != "" {
+		if config.APISecret != "" {
 			fmt.Println("API Secret:       ***")
 		} else {
 			fmt.Println("API Secret:       Not set")
 		}
+		fmt.Printf("Sign Requests:    %t\n", config.SignRequests)
 
-		fmt.Printf("Base URL:         %s\n", baseURL)
+		fmt.Printf("Base URL:         %s\n", config.BaseURL)
 
-		if orgID != "" {
-			fmt.Printf("Organization ID:  %s\n", orgID)
+		if config.OrganizationID != "" {
+			fmt.Printf("Organization ID:  %s\n", config.OrganizationID)
 		} else {
 			fmt.Println("Organization ID:  Not set")
 		}
 
+		if config.ProjectID != "" {
+			fmt.Printf("Project ID:       %s\n", config.ProjectID)
+		}
+
+		fmt.Printf("Timeout:          %s\n", config.Timeout)
 		fmt.Printf("Output Format:    %s\n", outputFormat)
 		fmt.Printf("Verbose:          %v\n", verbose)
+
+		var configErrs ainative.ConfigErrors
+		if errors.As(err, &configErrs) {
+			fmt.Println()
+			fmt.Println("Problems:")
+			for _, configErr := range configErrs {
+				fmt.Printf("  - %s: %s\n", configErr.Field, configErr.Message)
+			}
+		}
 	},
 }
 
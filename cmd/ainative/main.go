@@ -20,6 +20,8 @@ var (
 	apiSecret   string
 	baseURL     string
 	orgID       string
+	profile     string
+	configFile  string
 	verbose     bool
 	outputFormat string
 
@@ -50,25 +52,13 @@ func main() {
 }
 
 func init() {
-	// Global flags
+	// Global flags override the config file and AINATIVE_* environment variables
 	rootCmd.PersistentFlags().StringVar(&apiKey, "api-key", "", "AINative API key (or set AINATIVE_API_KEY)")
 	rootCmd.PersistentFlags().StringVar(&apiSecret, "api-secret", "", "API secret (or set AINATIVE_API_SECRET)")
-	rootCmd.PersistentFlags().StringVar(&baseURL, "base-url", "https://api.ainative.studio", "API base URL")
-	rootCmd.PersistentFlags().StringVar(&orgID, "org-id", "", "Organization ID")
+	rootCmd.PersistentFlags().StringVar(&baseURL, "base-url", "", "API base URL (or set AINATIVE_BASE_URL)")
+	rootCmd.PersistentFlags().StringVar(&orgID, "org-id", "", "Organization ID (or set AINATIVE_ORG_ID)")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "Config file profile (or set AINATIVE_PROFILE)")
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "Config file (defaults to ~/.config/ainative/config.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Verbose output")
 	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "json", "Output format (json|table|yaml)")
-
-	// Read from environment variables
-	if apiKey == "" {
-		apiKey = os.Getenv("AINATIVE_API_KEY")
-	}
-	if apiSecret == "" {
-		apiSecret = os.Getenv("AINATIVE_API_SECRET")
-	}
-	if os.Getenv("AINATIVE_BASE_URL") != "" {
-		baseURL = os.Getenv("AINATIVE_BASE_URL")
-	}
-	if orgID == "" {
-		orgID = os.Getenv("AINATIVE_ORG_ID")
-	}
 }
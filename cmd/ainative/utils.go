@@ -9,17 +9,32 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// getClient creates and returns an AINative client instance
-func getClient() (*ainative.Client, error) {
-	if apiKey == "" {
-		return nil, fmt.Errorf("API key is required. Set AINATIVE_API_KEY environment variable or use --api-key flag")
+// loadConfig builds the client configuration from the config file profile,
+// AINATIVE_* environment variables and command-line flags
+func loadConfig() (*ainative.Config, error) {
+	opts := []ainative.LoadOption{
+		ainative.WithOverrides(&ainative.Config{
+			APIKey:         apiKey,
+			APISecret:      apiSecret,
+			BaseURL:        baseURL,
+			OrganizationID: orgID,
+		}),
+	}
+	if profile != "" {
+		opts = append(opts, ainative.WithProfile(profile))
+	}
+	if configFile != "" {
+		opts = append(opts, ainative.WithConfigFile(configFile))
 	}
 
-	config := &ainative.ClientConfig{
-		APIKey:    apiKey,
-		APISecret: apiSecret,
-		BaseURL:   baseURL,
-		OrgID:     orgID,
+	return ainative.LoadConfig(opts...)
+}
+
+// getClient creates and returns an AINative client instance
+func getClient() (*ainative.Client, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
 	}
 
 	return ainative.NewClient(config)